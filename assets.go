@@ -0,0 +1,156 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Asset defines a file uploaded to a site.
+type Asset struct {
+	ID               string    `json:"id"`
+	OriginalFileName string    `json:"originalFileName"`
+	DisplayName      string    `json:"displayName"`
+	ContentType      string    `json:"contentType"`
+	Size             int64     `json:"size"`
+	HostedURL        string    `json:"hostedUrl"`
+	CreatedOn        time.Time `json:"createdOn"`
+}
+
+// UploadAsset uploads the file at filePath to the site's assets.
+func (m *Webflow) UploadAsset(siteID, filePath string) (*Asset, error) {
+	return m.UploadAssetCtx(context.Background(), siteID, filePath)
+}
+
+// UploadAssetCtx is like UploadAsset but uses ctx for the request.
+func (m *Webflow) UploadAssetCtx(ctx context.Context, siteID, filePath string) (*Asset, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if filePath == "" {
+		return nil, ErrorMissingFilePath
+	}
+	var asset Asset
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/sites/%s/assets", siteID),
+		file:   filePath,
+	}, &asset); err != nil {
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// ListAssets returns a page of assets uploaded to the site.
+func (m *Webflow) ListAssets(siteID string, p Param) ([]Asset, error) {
+	return m.ListAssetsCtx(context.Background(), siteID, p)
+}
+
+// ListAssetsCtx is like ListAssets but uses ctx for the request.
+func (m *Webflow) ListAssetsCtx(ctx context.Context, siteID string, p Param) ([]Asset, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	path := withQuery(fmt.Sprintf("/sites/%s/assets", siteID), p.toQuery())
+	var res struct {
+		Assets []Asset `json:"assets"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       path,
+		apiVersion: APIVersion2,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []Asset{}, nil
+		}
+		return nil, err
+	}
+	return res.Assets, nil
+}
+
+// DeleteAsset deletes the asset with the given ID. An asset that doesn't exist is
+// returned as an Error with a 404 code.
+func (m *Webflow) DeleteAsset(assetID string) error {
+	return m.DeleteAssetCtx(context.Background(), assetID)
+}
+
+// DeleteAssetCtx is like DeleteAsset but uses ctx for the request.
+func (m *Webflow) DeleteAssetCtx(ctx context.Context, assetID string) error {
+	if assetID == "" {
+		return ErrorMissingAssetID
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method:     http.MethodDelete,
+		path:       fmt.Sprintf("/assets/%s", assetID),
+		apiVersion: APIVersion2,
+	}, nil)
+}
+
+// AssetFolder defines a folder organizing the assets of a site.
+type AssetFolder struct {
+	ID           string `json:"id"`
+	DisplayName  string `json:"displayName"`
+	ParentFolder string `json:"parentFolder"`
+	// Assets holds the IDs of the assets in the folder.
+	Assets []string `json:"assets"`
+}
+
+// ListAssetFolders returns the asset folders of the site.
+func (m *Webflow) ListAssetFolders(siteID string) ([]AssetFolder, error) {
+	return m.ListAssetFoldersCtx(context.Background(), siteID)
+}
+
+// ListAssetFoldersCtx is like ListAssetFolders but uses ctx for the request.
+func (m *Webflow) ListAssetFoldersCtx(ctx context.Context, siteID string) ([]AssetFolder, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	var res struct {
+		AssetFolders []AssetFolder `json:"assetFolders"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       fmt.Sprintf("/sites/%s/asset_folders", siteID),
+		apiVersion: APIVersion2,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []AssetFolder{}, nil
+		}
+		return nil, err
+	}
+	return res.AssetFolders, nil
+}
+
+// CreateAssetFolder creates an asset folder on the site with the given name, inside
+// the folder with the given ID or at the top level when parentFolderID is empty.
+func (m *Webflow) CreateAssetFolder(siteID, displayName, parentFolderID string) (*AssetFolder, error) {
+	return m.CreateAssetFolderCtx(context.Background(), siteID, displayName, parentFolderID)
+}
+
+// CreateAssetFolderCtx is like CreateAssetFolder but uses ctx for the request.
+func (m *Webflow) CreateAssetFolderCtx(ctx context.Context, siteID, displayName, parentFolderID string) (*AssetFolder, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if displayName == "" {
+		return nil, errors.New("missing webflow asset folder name")
+	}
+	data := map[string]interface{}{
+		"displayName": displayName,
+	}
+	if parentFolderID != "" {
+		data["parentFolder"] = parentFolderID
+	}
+	var folder AssetFolder
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodPost,
+		path:       fmt.Sprintf("/sites/%s/asset_folders", siteID),
+		data:       data,
+		apiVersion: APIVersion2,
+	}, &folder); err != nil {
+		return nil, err
+	}
+	return &folder, nil
+}
@@ -0,0 +1,182 @@
+package webflow
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fakeFS is a fileOpener serving files from memory.
+type fakeFS map[string]string
+
+// Open returns the contents of the named file.
+func (fs fakeFS) Open(name string) (io.ReadCloser, error) {
+	c, ok := fs[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return ioutil.NopCloser(bytes.NewBufferString(c)), nil
+}
+
+func TestUploadAsset(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"id": "a1", "originalFileName": "logo \"v2\".png", "contentType": "image/png", "size": 4}`))
+	m.fs = fakeFS{"/images/logo \"v2\".png": "\x89PNG"}
+
+	asset, err := m.UploadAsset("s1", "/images/logo \"v2\".png")
+	if err != nil {
+		t.Fatalf("UploadAsset: %v", err)
+	}
+	if asset.ID != "a1" || asset.Size != 4 {
+		t.Errorf("unexpected asset %+v", asset)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/sites/s1/assets")
+	mt, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mt != "multipart/form-data" {
+		t.Fatalf("Content-Type = %q, want multipart/form-data", r.Header.Get("Content-Type"))
+	}
+	part, err := multipart.NewReader(bytes.NewReader(r.Body), params["boundary"]).NextPart()
+	if err != nil {
+		t.Fatalf("reading multipart body: %v", err)
+	}
+	if part.FormName() != "file" || part.FileName() != `logo "v2".png` {
+		t.Errorf("part name %q, filename %q, want file, logo \"v2\".png", part.FormName(), part.FileName())
+	}
+	if ct := part.Header.Get("Content-Type"); ct != "image/png" {
+		t.Errorf("part Content-Type = %q, want image/png", ct)
+	}
+	if b, _ := ioutil.ReadAll(part); string(b) != "\x89PNG" {
+		t.Errorf("part contents = %q, want the file contents", b)
+	}
+}
+
+func TestUploadAssetMissingFile(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+	m.fs = fakeFS{}
+
+	if _, err := m.UploadAsset("s1", "missing.png"); err == nil {
+		t.Error("UploadAsset of a missing file succeeded")
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestListAssets(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"assets": [
+		{"id": "a1", "originalFileName": "logo.png", "displayName": "Logo", "contentType": "image/png", "size": 1024, "hostedUrl": "https://cdn.example.com/logo.png", "createdOn": "2022-05-06T07:08:09.000Z"},
+		{"id": "a2", "originalFileName": "terms.pdf", "contentType": "application/pdf", "size": 20480}
+	], "pagination": {"limit": 2, "offset": 0, "total": 2}}`))
+
+	assets, err := m.ListAssets("s1", Param{PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListAssets: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodGet, "/v2/sites/s1/assets")
+	if got := r.Query.Get("limit"); got != "2" {
+		t.Errorf("limit = %q, want 2", got)
+	}
+	want := Asset{
+		ID:               "a1",
+		OriginalFileName: "logo.png",
+		DisplayName:      "Logo",
+		ContentType:      "image/png",
+		Size:             1024,
+		HostedURL:        "https://cdn.example.com/logo.png",
+		CreatedOn:        time.Date(2022, 5, 6, 7, 8, 9, 0, time.UTC),
+	}
+	if len(assets) != 2 || !reflect.DeepEqual(assets[0], want) {
+		t.Fatalf("assets = %+v, want %+v first", assets, want)
+	}
+	if assets[1].ContentType != "application/pdf" || assets[1].Size != 20480 {
+		t.Errorf("unexpected asset %+v", assets[1])
+	}
+}
+
+func TestDeleteAsset(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := m.DeleteAsset("a1"); err != nil {
+		t.Fatalf("DeleteAsset: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodDelete, "/v2/assets/a1")
+	if err := m.DeleteAsset(""); err != ErrorMissingAssetID {
+		t.Errorf("DeleteAsset(\"\") error = %v, want %v", err, ErrorMissingAssetID)
+	}
+}
+
+func TestDeleteAssetNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, `{"code": "resource_not_found", "message": "Requested resource not found"}`))
+
+	var e Error
+	if err := m.DeleteAsset("a1"); !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("DeleteAsset error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestListAssetFolders(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"assetFolders": [
+		{"id": "af1", "displayName": "Images", "assets": ["a1", "a2"]},
+		{"id": "af2", "displayName": "Logos", "parentFolder": "af1", "assets": []}
+	]}`))
+
+	folders, err := m.ListAssetFolders("s1")
+	if err != nil {
+		t.Fatalf("ListAssetFolders: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/v2/sites/s1/asset_folders")
+	if len(folders) != 2 {
+		t.Fatalf("got %d folders, want 2", len(folders))
+	}
+	if f := folders[0]; f.ID != "af1" || f.DisplayName != "Images" || f.ParentFolder != "" || !reflect.DeepEqual(f.Assets, []string{"a1", "a2"}) {
+		t.Errorf("unexpected folder %+v", f)
+	}
+	if f := folders[1]; f.ParentFolder != "af1" {
+		t.Errorf("nested folder parent = %q, want af1", f.ParentFolder)
+	}
+}
+
+func TestCreateAssetFolder(t *testing.T) {
+	tests := []struct {
+		parent string
+		want   map[string]interface{}
+	}{
+		{"", map[string]interface{}{"displayName": "Images"}},
+		{"af1", map[string]interface{}{"displayName": "Images", "parentFolder": "af1"}},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{"id": "af2", "displayName": "Images", "parentFolder": "`+tt.parent+`"}`))
+
+		folder, err := m.CreateAssetFolder("s1", "Images", tt.parent)
+		if err != nil {
+			t.Fatalf("CreateAssetFolder(parent %q): %v", tt.parent, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPost, "/v2/sites/s1/asset_folders")
+		if got := r.jsonBody(t); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parent %q: body = %v, want %v", tt.parent, got, tt.want)
+		}
+		if folder.ID != "af2" || folder.ParentFolder != tt.parent {
+			t.Errorf("unexpected folder %+v", folder)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package webflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Collection defines a CMS collection of a site.
+type Collection struct {
+	ID          string    `json:"_id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Singular    string    `json:"singularName"`
+	CreatedOn   time.Time `json:"createdOn"`
+	LastUpdated time.Time `json:"lastUpdated"`
+	Fields      []Field   `json:"fields,omitempty"`
+}
+
+// UnmarshalJSON decodes a collection as returned by either version of the API.
+func (c *Collection) UnmarshalJSON(b []byte) error {
+	type collection Collection
+	var res struct {
+		collection
+		V2ID        string `json:"id"`
+		DisplayName string `json:"displayName"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	if res.ID == "" {
+		res.ID = res.V2ID
+	}
+	if res.Name == "" {
+		res.Name = res.DisplayName
+	}
+	*c = Collection(res.collection)
+	return nil
+}
+
+// Field defines a field of a collection's schema.
+type Field struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Slug        string          `json:"slug"`
+	Type        string          `json:"type"`
+	Required    bool            `json:"required"`
+	Editable    bool            `json:"editable"`
+	HelpText    string          `json:"helpText,omitempty"`
+	Validations json.RawMessage `json:"validations,omitempty"`
+	// Metadata holds type-specific settings of a new field, such as the choices of an
+	// Option field.
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+}
+
+// collectionFieldTypes is the set of field types that can be added to a collection.
+var collectionFieldTypes = map[string]bool{
+	"PlainText":      true,
+	"RichText":       true,
+	"Image":          true,
+	"MultiImage":     true,
+	"Video":          true,
+	"Link":           true,
+	"Email":          true,
+	"Phone":          true,
+	"Number":         true,
+	"DateTime":       true,
+	"Switch":         true,
+	"Color":          true,
+	"File":           true,
+	"Option":         true,
+	"Reference":      true,
+	"MultiReference": true,
+}
+
+// UnmarshalJSON decodes a field as returned by either version of the API.
+func (f *Field) UnmarshalJSON(b []byte) error {
+	type field Field
+	var res struct {
+		field
+		DisplayName string `json:"displayName"`
+		IsRequired  *bool  `json:"isRequired"`
+		IsEditable  *bool  `json:"isEditable"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	if res.Name == "" {
+		res.Name = res.DisplayName
+	}
+	if res.IsRequired != nil {
+		res.Required = *res.IsRequired
+	}
+	if res.IsEditable != nil {
+		res.Editable = *res.IsEditable
+	}
+	*f = Field(res.field)
+	return nil
+}
+
+// v2Field returns the field as the body the v2 API expects for a new field.
+func (f Field) v2Field() map[string]interface{} {
+	data := map[string]interface{}{
+		"type":        f.Type,
+		"displayName": f.Name,
+		"isRequired":  f.Required,
+	}
+	if f.Slug != "" {
+		data["slug"] = f.Slug
+	}
+	if f.HelpText != "" {
+		data["helpText"] = f.HelpText
+	}
+	if f.Validations != nil {
+		data["validations"] = f.Validations
+	}
+	if f.Metadata != nil {
+		data["metadata"] = f.Metadata
+	}
+	return data
+}
+
+// ListCollections returns the collections of the site. Collection fields are not
+// included; use GetCollection to fetch a collection's schema.
+func (m *Webflow) ListCollections(siteID string) ([]Collection, error) {
+	return m.ListCollectionsCtx(context.Background(), siteID)
+}
+
+// ListCollectionsCtx is like ListCollections but uses ctx for the request.
+func (m *Webflow) ListCollectionsCtx(ctx context.Context, siteID string) ([]Collection, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	var collections []Collection
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s/collections", siteID),
+	}, &collections); err != nil {
+		if isEmptyListError(err) {
+			return []Collection{}, nil
+		}
+		return nil, err
+	}
+	return collections, nil
+}
+
+// listAllConcurrency is the number of sites ListAllCollections fetches at a time.
+const listAllConcurrency = 4
+
+// ListAllCollections returns the collections of every site the access token has access
+// to, keyed by site ID. Sites whose collections couldn't be fetched are left out of the
+// map and reported together in the returned error.
+func (m *Webflow) ListAllCollections() (map[string][]Collection, error) {
+	return m.ListAllCollectionsCtx(context.Background())
+}
+
+// ListAllCollectionsCtx is like ListAllCollections but uses ctx for the requests.
+func (m *Webflow) ListAllCollectionsCtx(ctx context.Context) (map[string][]Collection, error) {
+	sites, err := m.ListSitesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collections := make([][]Collection, len(sites))
+	errs := make([]error, len(sites))
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	for w := 0; w < listAllConcurrency && w < len(sites); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				collections[i], errs[i] = m.ListCollectionsCtx(ctx, sites[i].ID)
+			}
+		}()
+	}
+	for i := range sites {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	all := make(map[string][]Collection, len(sites))
+	var problems []string
+	for i, site := range sites {
+		if errs[i] != nil {
+			problems = append(problems, fmt.Sprintf("site %s: %v", site.ID, errs[i]))
+			continue
+		}
+		all[site.ID] = collections[i]
+	}
+	if len(problems) > 0 {
+		return all, fmt.Errorf("listing webflow collections failed: %s", strings.Join(problems, "; "))
+	}
+	return all, nil
+}
+
+// GetCollection returns the collection with the given ID, including its fields.
+func (m *Webflow) GetCollection(collectionID string) (*Collection, error) {
+	return m.GetCollectionCtx(context.Background(), collectionID)
+}
+
+// GetCollectionCtx is like GetCollection but uses ctx for the request.
+func (m *Webflow) GetCollectionCtx(ctx context.Context, collectionID string) (*Collection, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	var collection Collection
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/collections/%s", collectionID),
+	}, &collection); err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// CreateCollection creates a collection on the site with the given fields, which are
+// added next to the name and slug fields every collection has.
+func (m *Webflow) CreateCollection(siteID string, name, slug, singular string, fields []Field) (*Collection, error) {
+	return m.CreateCollectionCtx(context.Background(), siteID, name, slug, singular, fields)
+}
+
+// CreateCollectionCtx is like CreateCollection but uses ctx for the request.
+func (m *Webflow) CreateCollectionCtx(ctx context.Context, siteID string, name, slug, singular string, fields []Field) (*Collection, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if name == "" {
+		return nil, errors.New("missing webflow collection name")
+	}
+	if slug == "" {
+		return nil, errors.New("missing webflow collection slug")
+	}
+	data := map[string]interface{}{
+		"displayName": name,
+		"slug":        slug,
+	}
+	if singular != "" {
+		data["singularName"] = singular
+	}
+	if len(fields) > 0 {
+		f := make([]map[string]interface{}, len(fields))
+		for i, field := range fields {
+			if !collectionFieldTypes[field.Type] {
+				return nil, fmt.Errorf("unknown webflow field type %q of field %q", field.Type, field.Name)
+			}
+			f[i] = field.v2Field()
+		}
+		data["fields"] = f
+	}
+	var collection Collection
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodPost,
+		path:       fmt.Sprintf("/sites/%s/collections", siteID),
+		data:       data,
+		apiVersion: APIVersion2,
+	}, &collection); err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// DeleteCollection deletes the collection with the given ID along with all of its
+// items. A collection that doesn't exist is returned as an Error with a 404 code.
+func (m *Webflow) DeleteCollection(collectionID string) error {
+	return m.DeleteCollectionCtx(context.Background(), collectionID)
+}
+
+// DeleteCollectionCtx is like DeleteCollection but uses ctx for the request.
+func (m *Webflow) DeleteCollectionCtx(ctx context.Context, collectionID string) error {
+	if collectionID == "" {
+		return ErrorMissingCollectionID
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method:     http.MethodDelete,
+		path:       fmt.Sprintf("/collections/%s", collectionID),
+		apiVersion: APIVersion2,
+	}, nil)
+}
+
+// CreateCollectionField adds the field to the collection's schema and returns the
+// created field.
+func (m *Webflow) CreateCollectionField(collectionID string, field Field) (*Field, error) {
+	return m.CreateCollectionFieldCtx(context.Background(), collectionID, field)
+}
+
+// CreateCollectionFieldCtx is like CreateCollectionField but uses ctx for the request.
+func (m *Webflow) CreateCollectionFieldCtx(ctx context.Context, collectionID string, field Field) (*Field, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	if !collectionFieldTypes[field.Type] {
+		return nil, fmt.Errorf("unknown webflow field type %q", field.Type)
+	}
+	var f Field
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodPost,
+		path:       fmt.Sprintf("/collections/%s/fields", collectionID),
+		data:       field.v2Field(),
+		apiVersion: APIVersion2,
+	}, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
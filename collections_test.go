@@ -0,0 +1,230 @@
+package webflow
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetCollection(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{
+		"_id": "c1",
+		"name": "Posts",
+		"slug": "post",
+		"singularName": "Post",
+		"lastUpdated": "2022-02-03T04:05:06Z",
+		"createdOn": "2021-02-03T04:05:06Z",
+		"fields": [
+			{"id": "f1", "name": "Name", "slug": "name", "type": "PlainText", "required": true, "editable": true, "validations": {"maxLength": 256}},
+			{"id": "f2", "name": "Body", "slug": "body", "type": "RichText", "required": false, "editable": true},
+			{"id": "f3", "name": "Cover", "slug": "cover", "type": "ImageRef", "editable": true},
+			{"id": "f4", "name": "Author", "slug": "author", "type": "ItemRef", "editable": true, "validations": {"collectionId": "c2"}},
+			{"id": "f5", "name": "Kind", "slug": "kind", "type": "Option", "editable": true, "validations": {"options": [{"name": "News"}, {"name": "Blog"}]}}
+		]
+	}`))
+
+	c, err := m.GetCollection("c1")
+	if err != nil {
+		t.Fatalf("GetCollection: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/collections/c1")
+	if c.ID != "c1" || c.Name != "Posts" || c.Slug != "post" || c.Singular != "Post" {
+		t.Errorf("unexpected collection %+v", c)
+	}
+	wantTypes := []string{"PlainText", "RichText", "ImageRef", "ItemRef", "Option"}
+	if len(c.Fields) != len(wantTypes) {
+		t.Fatalf("got %d fields, want %d", len(c.Fields), len(wantTypes))
+	}
+	for i, typ := range wantTypes {
+		if c.Fields[i].Type != typ {
+			t.Errorf("field %d type = %q, want %q", i, c.Fields[i].Type, typ)
+		}
+	}
+	name := c.Fields[0]
+	if name.ID != "f1" || name.Slug != "name" || !name.Required || !name.Editable || string(name.Validations) != `{"maxLength": 256}` {
+		t.Errorf("unexpected name field %+v", name)
+	}
+	if c.Fields[1].Required {
+		t.Error("body field is required, want optional")
+	}
+	if got := string(c.Fields[3].Validations); got != `{"collectionId": "c2"}` {
+		t.Errorf("author validations = %s", got)
+	}
+}
+
+func TestCreateCollectionField(t *testing.T) {
+	tests := []struct {
+		field Field
+		want  map[string]interface{}
+	}{
+		{Field{Name: "Subtitle", Type: "PlainText", HelpText: "Shown below the title"},
+			map[string]interface{}{"type": "PlainText", "displayName": "Subtitle", "isRequired": false, "helpText": "Shown below the title"}},
+		{Field{Name: "Color", Slug: "color", Type: "Option", Required: true, Metadata: json.RawMessage(`{"options": [{"name": "Red"}, {"name": "Blue"}]}`)},
+			map[string]interface{}{"type": "Option", "displayName": "Color", "slug": "color", "isRequired": true, "metadata": map[string]interface{}{
+				"options": []interface{}{map[string]interface{}{"name": "Red"}, map[string]interface{}{"name": "Blue"}},
+			}}},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{"id": "f1", "displayName": "`+tt.field.Name+`", "type": "`+tt.field.Type+`", "isRequired": false, "isEditable": true}`))
+
+		field, err := m.CreateCollectionField("c1", tt.field)
+		if err != nil {
+			t.Fatalf("CreateCollectionField(%s): %v", tt.field.Type, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPost, "/v2/collections/c1/fields")
+		if got := r.jsonBody(t); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: body = %v, want %v", tt.field.Type, got, tt.want)
+		}
+		if field.ID != "f1" || field.Name != tt.field.Name || field.Type != tt.field.Type {
+			t.Errorf("unexpected field %+v", field)
+		}
+	}
+}
+
+func TestCreateCollectionFieldErrors(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusBadRequest, `{"code": "validation_error", "message": "Validation Error", "details": [{"param": "displayName", "description": "already in use"}]}`))
+
+	if _, err := m.CreateCollectionField("c1", Field{Name: "Subtitle", Type: "Plaintext"}); err == nil {
+		t.Error("CreateCollectionField with an unknown type succeeded")
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made for an unknown type, want none", n)
+	}
+	_, err := m.CreateCollectionField("c1", Field{Name: "Subtitle", Type: "PlainText"})
+	var e Error
+	if !errors.As(err, &e) || e.Status != http.StatusBadRequest || len(e.Problems) != 1 {
+		t.Errorf("CreateCollectionField error = %#v, want a validation Error", err)
+	}
+}
+
+func TestCreateCollection(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{
+		"id": "c1", "displayName": "Posts", "singularName": "Post", "slug": "posts",
+		"fields": [{"id": "f1", "displayName": "Title", "slug": "title", "type": "PlainText", "isRequired": true}]
+	}`))
+
+	collection, err := m.CreateCollection("s1", "Posts", "posts", "Post", []Field{{Name: "Title", Slug: "title", Type: "PlainText", Required: true}})
+	if err != nil {
+		t.Fatalf("CreateCollection: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/v2/sites/s1/collections")
+	want := map[string]interface{}{
+		"displayName":  "Posts",
+		"slug":         "posts",
+		"singularName": "Post",
+		"fields": []interface{}{
+			map[string]interface{}{"type": "PlainText", "displayName": "Title", "slug": "title", "isRequired": true},
+		},
+	}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+	if collection.ID != "c1" || len(collection.Fields) != 1 || collection.Fields[0].ID != "f1" {
+		t.Errorf("unexpected collection %+v", collection)
+	}
+}
+
+func TestCreateCollectionInvalid(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	for _, tt := range []struct {
+		name, slug string
+		fields     []Field
+	}{
+		{"", "posts", nil},
+		{"Posts", "", nil},
+		{"Posts", "posts", []Field{{Name: "Title", Type: "Text"}}},
+	} {
+		if _, err := m.CreateCollection("s1", tt.name, tt.slug, "", tt.fields); err == nil {
+			t.Errorf("CreateCollection(%q, %q, %v) succeeded", tt.name, tt.slug, tt.fields)
+		}
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := m.DeleteCollection("c1"); err != nil {
+		t.Fatalf("DeleteCollection: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodDelete, "/v2/collections/c1")
+	if err := m.DeleteCollection(""); err != ErrorMissingCollectionID {
+		t.Errorf("DeleteCollection(\"\") error = %v, want %v", err, ErrorMissingCollectionID)
+	}
+}
+
+func TestDeleteCollectionNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, `{"code": "resource_not_found", "message": "Requested resource not found"}`))
+
+	var e Error
+	if err := m.DeleteCollection("c1"); !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("DeleteCollection error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestListAllCollections(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sites":
+			rec.reply(http.StatusOK, `[{"_id": "s1"}, {"_id": "s2"}]`)(w, r)
+		case "/sites/s1/collections":
+			rec.reply(http.StatusOK, `[{"_id": "c1", "slug": "posts"}, {"_id": "c2", "slug": "authors"}]`)(w, r)
+		case "/sites/s2/collections":
+			rec.reply(http.StatusOK, `[{"_id": "c3", "slug": "products"}]`)(w, r)
+		default:
+			rec.reply(http.StatusNotFound, `{"msg": "Route not found", "code": 404}`)(w, r)
+		}
+	})
+
+	all, err := m.ListAllCollections()
+	if err != nil {
+		t.Fatalf("ListAllCollections: %v", err)
+	}
+	if len(all) != 2 || len(all["s1"]) != 2 || len(all["s2"]) != 1 {
+		t.Fatalf("unexpected collections %+v", all)
+	}
+	if all["s1"][1].ID != "c2" || all["s2"][0].Slug != "products" {
+		t.Errorf("unexpected collections %+v", all)
+	}
+}
+
+func TestListAllCollectionsError(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sites":
+			rec.reply(http.StatusOK, `[{"_id": "s1"}, {"_id": "s2"}]`)(w, r)
+		case "/sites/s1/collections":
+			rec.reply(http.StatusOK, `[{"_id": "c1"}]`)(w, r)
+		default:
+			rec.reply(http.StatusForbidden, `{"msg": "Forbidden", "code": 403}`)(w, r)
+		}
+	})
+
+	all, err := m.ListAllCollections()
+	if err == nil || !strings.Contains(err.Error(), "site s2") {
+		t.Errorf("ListAllCollections error = %v, want one naming site s2", err)
+	}
+	if len(all["s1"]) != 1 {
+		t.Errorf("the collections of the site that succeeded are missing: %+v", all)
+	}
+}
@@ -0,0 +1,55 @@
+package webflow
+
+import (
+	"context"
+	"time"
+)
+
+// contextKey is the type of the keys of the per-request settings carried by a context.
+type contextKey int
+
+const (
+	// versionKey is the key of the API version requests are made with.
+	versionKey contextKey = iota
+	// timeoutKey is the key of the timeout requests are made with.
+	timeoutKey
+	// apiVersionKey is the key of the version of the API requests are routed to.
+	apiVersionKey
+)
+
+// WithRequestVersion returns a copy of ctx that makes the requests it's passed to send
+// version as their Accept-Version header instead of the client's Version, so a single
+// client can mix versions. Requests to the v2 API aren't versioned by header and ignore
+// it.
+func WithRequestVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, versionKey, version)
+}
+
+// WithRequestAPIVersion returns a copy of ctx that routes the requests it's passed to
+// version v of the API instead of the client's APIVersion. Requests to endpoints only
+// available in a specific version are still routed to it.
+func WithRequestAPIVersion(ctx context.Context, v APIVersion) context.Context {
+	return context.WithValue(ctx, apiVersionKey, v)
+}
+
+// WithRequestTimeout returns a copy of ctx that makes the requests it's passed to use
+// timeout instead of the client's Timeout, whether shorter or longer. Like Timeout, it
+// applies to every attempt of a request separately.
+func WithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey, timeout)
+}
+
+// withContextSettings returns the request with the settings carried by ctx filled in
+// where the request doesn't set them itself.
+func withContextSettings(ctx context.Context, cr clientRequest) clientRequest {
+	if v, ok := ctx.Value(versionKey).(string); ok && cr.version == "" {
+		cr.version = v
+	}
+	if d, ok := ctx.Value(timeoutKey).(time.Duration); ok && cr.timeout == 0 {
+		cr.timeout = d
+	}
+	if v, ok := ctx.Value(apiVersionKey).(APIVersion); ok && cr.apiVersion == 0 {
+		cr.apiVersion = v
+	}
+	return cr
+}
@@ -0,0 +1,86 @@
+package webflow
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithRequestVersion(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[]`))
+
+	if _, err := m.ListSitesCtx(WithRequestVersion(context.Background(), "2.0.0")); err != nil {
+		t.Fatalf("ListSitesCtx: %v", err)
+	}
+	if got := rec.last(t).Header.Get("Accept-Version"); got != "2.0.0" {
+		t.Errorf("Accept-Version = %q, want the overridden 2.0.0", got)
+	}
+	if _, err := m.ListSitesCtx(context.Background()); err != nil {
+		t.Fatalf("ListSitesCtx: %v", err)
+	}
+	if got := rec.last(t).Header.Get("Accept-Version"); got != defaultVersion {
+		t.Errorf("Accept-Version = %q without an override, want %q", got, defaultVersion)
+	}
+	if err := m.DoCtx(WithRequestVersion(context.Background(), "1.1.0"), http.MethodGet, "/info", nil, nil); err != nil {
+		t.Fatalf("DoCtx: %v", err)
+	}
+	if got := rec.last(t).Header.Get("Accept-Version"); got != "1.1.0" {
+		t.Errorf("Accept-Version = %q through DoCtx, want 1.1.0", got)
+	}
+}
+
+func TestWithRequestAPIVersion(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "s1"}`))
+
+	if _, err := m.GetSiteCtx(WithRequestAPIVersion(context.Background(), APIVersion2), "s1"); err != nil {
+		t.Fatalf("GetSiteCtx: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodGet, "/v2/sites/s1")
+	if got := r.Header.Get("Accept-Version"); got != "" {
+		t.Errorf("Accept-Version = %q on v2, want none", got)
+	}
+	if _, err := m.GetSiteCtx(context.Background(), "s1"); err != nil {
+		t.Fatalf("GetSiteCtx: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1")
+}
+
+// slowSite returns a handler replying after delay, or once the request is cancelled.
+func slowSite(delay time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+			w.Write([]byte(`[]`))
+		case <-r.Context().Done():
+		}
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	m := newTestClient(t, slowSite(300*time.Millisecond), WithTimeout(time.Minute))
+
+	start := time.Now()
+	_, err := m.ListSitesCtx(WithRequestTimeout(context.Background(), 20*time.Millisecond))
+	if err == nil || !strings.Contains(err.Error(), "Timeout") {
+		t.Errorf("ListSitesCtx error = %v, want a timeout", err)
+	}
+	if d := time.Since(start); d > 200*time.Millisecond {
+		t.Errorf("the request took %s, want it cancelled after the per-request timeout", d)
+	}
+}
+
+func TestWithRequestTimeoutLongerThanClient(t *testing.T) {
+	m := newTestClient(t, slowSite(50*time.Millisecond), WithTimeout(10*time.Millisecond))
+
+	if _, err := m.ListSites(); err == nil {
+		t.Fatal("ListSites succeeded despite the client's timeout")
+	}
+	if _, err := m.ListSitesCtx(WithRequestTimeout(context.Background(), 5*time.Second)); err != nil {
+		t.Errorf("ListSitesCtx with a longer per-request timeout: %v", err)
+	}
+}
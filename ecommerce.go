@@ -0,0 +1,36 @@
+package webflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// EcommerceSettings defines the e-commerce settings of a site.
+type EcommerceSettings struct {
+	SiteID            string    `json:"site"`
+	CreatedOn         time.Time `json:"createdOn"`
+	DefaultCurrency   string    `json:"defaultCurrency"`
+	EnabledCurrencies []string  `json:"enabledCurrencies"`
+}
+
+// GetEcommerceSettings returns the e-commerce settings of the site.
+func (m *Webflow) GetEcommerceSettings(siteID string) (*EcommerceSettings, error) {
+	return m.GetEcommerceSettingsCtx(context.Background(), siteID)
+}
+
+// GetEcommerceSettingsCtx is like GetEcommerceSettings but uses ctx for the request.
+func (m *Webflow) GetEcommerceSettingsCtx(ctx context.Context, siteID string) (*EcommerceSettings, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	var settings EcommerceSettings
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s/ecommerce/settings", siteID),
+	}, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
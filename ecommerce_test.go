@@ -0,0 +1,35 @@
+package webflow
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetEcommerceSettings(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "58")
+		rec.reply(http.StatusOK, `{"site": "s1", "createdOn": "2021-06-07T08:09:10Z", "defaultCurrency": "USD", "enabledCurrencies": ["USD", "EUR"]}`)(w, r)
+	})
+
+	settings, err := m.GetEcommerceSettings("s1")
+	if err != nil {
+		t.Fatalf("GetEcommerceSettings: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1/ecommerce/settings")
+	want := EcommerceSettings{
+		SiteID:            "s1",
+		CreatedOn:         time.Date(2021, 6, 7, 8, 9, 10, 0, time.UTC),
+		DefaultCurrency:   "USD",
+		EnabledCurrencies: []string{"USD", "EUR"},
+	}
+	if !reflect.DeepEqual(*settings, want) {
+		t.Errorf("settings = %+v, want %+v", *settings, want)
+	}
+	if _, remaining := m.RateLimitStatus(); remaining != 58 {
+		t.Errorf("remaining = %d, want 58", remaining)
+	}
+}
@@ -0,0 +1,28 @@
+package webflow
+
+import "net/http"
+
+// etag returns the ETag to send with req, which is the one last returned for its URL
+// when ETags is set and req is a GET.
+func (m *Webflow) etag(req *http.Request) string {
+	if !m.ETags || req.Method != http.MethodGet {
+		return ""
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.etags[req.URL.String()]
+}
+
+// storeETag remembers tag as the ETag of the URL of req when ETags is set and req is a
+// GET.
+func (m *Webflow) storeETag(req *http.Request, tag string) {
+	if !m.ETags || req.Method != http.MethodGet || tag == "" {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.etags == nil {
+		m.etags = map[string]string{}
+	}
+	m.etags[req.URL.String()] = tag
+}
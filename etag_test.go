@@ -0,0 +1,54 @@
+package webflow
+
+import (
+	"net/http"
+	"testing"
+)
+
+// etagSite returns a handler serving a site with an ETag, replying with a 304 to
+// requests that send it back.
+func etagSite(rec *recorder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			rec.record(r)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		rec.reply(http.StatusOK, `{"_id": "s1", "name": "First"}`)(w, r)
+	}
+}
+
+func TestETags(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, etagSite(&rec), WithETags())
+
+	if _, err := m.GetSite("s1"); err != nil {
+		t.Fatalf("GetSite: %v", err)
+	}
+	if _, err := m.GetSite("s1"); err != ErrorNotModified {
+		t.Errorf("second GetSite error = %v, want %v", err, ErrorNotModified)
+	}
+	if got := rec.last(t).Header.Get("If-None-Match"); got != `"v1"` {
+		t.Errorf("If-None-Match = %q, want the stored ETag", got)
+	}
+	if _, err := m.ListSites(); err == ErrorNotModified {
+		t.Error("a GET of another URL sent the stored ETag")
+	}
+}
+
+func TestETagsDisabled(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, etagSite(&rec))
+
+	for i := 0; i < 2; i++ {
+		if _, err := m.GetSite("s1"); err != nil {
+			t.Fatalf("GetSite without ETags: %v", err)
+		}
+	}
+	for _, r := range rec.requests() {
+		if h := r.Header.Get("If-None-Match"); h != "" {
+			t.Errorf("If-None-Match = %q without ETags, want none", h)
+		}
+	}
+}
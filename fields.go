@@ -0,0 +1,49 @@
+package webflow
+
+// Reference fields of an item's Fields hold the ID of the referenced item as a string,
+// or an object with the referenced item's fields when it was expanded. MultiReference
+// fields hold a list of either. ItemRefID and ItemRefIDs read the IDs from both shapes.
+
+// ItemRefID returns the ID of the item referenced by the value of a Reference field. It
+// returns false when v isn't shaped like a reference.
+func ItemRefID(v interface{}) (string, bool) {
+	switch ref := v.(type) {
+	case string:
+		return ref, ref != ""
+	case map[string]interface{}:
+		for _, k := range []string{"_id", "id"} {
+			if id, ok := ref[k].(string); ok && id != "" {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ItemRefIDs returns the IDs of the items referenced by the value of a MultiReference
+// field. It returns false when v or any of its elements isn't shaped like a reference.
+func ItemRefIDs(v interface{}) ([]string, bool) {
+	switch refs := v.(type) {
+	case []string:
+		return refs, true
+	case []interface{}:
+		ids := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			id, ok := ItemRefID(ref)
+			if !ok {
+				return nil, false
+			}
+			ids = append(ids, id)
+		}
+		return ids, true
+	}
+	return nil, false
+}
+
+// ImageField returns the value of an Image field pointing at the image hosted at url,
+// which the API copies into the site's assets.
+func ImageField(url string) map[string]interface{} {
+	return map[string]interface{}{
+		"url": url,
+	}
+}
@@ -0,0 +1,72 @@
+package webflow
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestItemRefID(t *testing.T) {
+	tests := []struct {
+		v    interface{}
+		want string
+		ok   bool
+	}{
+		{"i1", "i1", true},
+		{map[string]interface{}{"_id": "i2", "name": "Second"}, "i2", true},
+		{map[string]interface{}{"id": "i3"}, "i3", true},
+		{"", "", false},
+		{nil, "", false},
+		{map[string]interface{}{"name": "No ID"}, "", false},
+		// Item fields hold numbers as json.Number, which is a string type but never a
+		// reference.
+		{json.Number("42"), "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := ItemRefID(tt.v); got != tt.want || ok != tt.ok {
+			t.Errorf("ItemRefID(%#v) = %q, %v, want %q, %v", tt.v, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestItemRefIDs(t *testing.T) {
+	tests := []struct {
+		v    interface{}
+		want []string
+		ok   bool
+	}{
+		{[]string{"i1", "i2"}, []string{"i1", "i2"}, true},
+		{[]interface{}{"i1", map[string]interface{}{"_id": "i2"}}, []string{"i1", "i2"}, true},
+		{[]interface{}{}, []string{}, true},
+		{"i1", nil, false},
+		{[]interface{}{"i1", json.Number("2")}, nil, false},
+	}
+	for _, tt := range tests {
+		if got, ok := ItemRefIDs(tt.v); !reflect.DeepEqual(got, tt.want) || ok != tt.ok {
+			t.Errorf("ItemRefIDs(%#v) = %#v, %v, want %#v, %v", tt.v, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestItemRefFieldsOfDecodedItem(t *testing.T) {
+	var item Item
+	if err := json.Unmarshal([]byte(`{"_id": "i1", "author": "a1", "tags": ["t1", "t2"], "rank": 7}`), &item); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if id, ok := ItemRefID(item.Fields["author"]); !ok || id != "a1" {
+		t.Errorf("author = %q, %v, want a1", id, ok)
+	}
+	if ids, ok := ItemRefIDs(item.Fields["tags"]); !ok || !reflect.DeepEqual(ids, []string{"t1", "t2"}) {
+		t.Errorf("tags = %v, %v, want [t1 t2]", ids, ok)
+	}
+	if _, ok := ItemRefID(item.Fields["rank"]); ok {
+		t.Error("a number field was read as a reference")
+	}
+}
+
+func TestImageField(t *testing.T) {
+	want := map[string]interface{}{"url": "https://cdn.example.com/a.png"}
+	if got := ImageField("https://cdn.example.com/a.png"); !reflect.DeepEqual(got, want) {
+		t.Errorf("ImageField = %v, want %v", got, want)
+	}
+}
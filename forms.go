@@ -0,0 +1,175 @@
+package webflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// Form defines a form of a site.
+type Form struct {
+	ID          string      `json:"id"`
+	SiteID      string      `json:"siteId"`
+	DisplayName string      `json:"displayName"`
+	PageID      string      `json:"pageId"`
+	PageName    string      `json:"pageName"`
+	Fields      []FormField `json:"-"`
+}
+
+// FormField defines an input of a form.
+type FormField struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+	Placeholder string `json:"placeholder"`
+	UserVisible bool   `json:"userVisible"`
+}
+
+// FormSubmission defines a submission of a form.
+type FormSubmission struct {
+	ID           string                 `json:"id"`
+	DisplayName  string                 `json:"displayName"`
+	SubmittedAt  time.Time              `json:"dateSubmitted"`
+	FormID       string                 `json:"formId"`
+	FormResponse map[string]interface{} `json:"formResponse"`
+}
+
+// UnmarshalJSON decodes the form, whose fields the API returns keyed by field ID.
+func (f *Form) UnmarshalJSON(b []byte) error {
+	type form Form
+	var res struct {
+		form
+		Fields map[string]FormField `json:"fields"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	fields := make([]FormField, 0, len(res.Fields))
+	for id, field := range res.Fields {
+		field.ID = id
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].ID < fields[j].ID })
+	res.form.Fields = fields
+	*f = Form(res.form)
+	return nil
+}
+
+// ListForms returns a page of forms of the site.
+func (m *Webflow) ListForms(siteID string, p Param) ([]Form, error) {
+	return m.ListFormsCtx(context.Background(), siteID, p)
+}
+
+// ListFormsCtx is like ListForms but uses ctx for the request.
+func (m *Webflow) ListFormsCtx(ctx context.Context, siteID string, p Param) ([]Form, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	path := withQuery(fmt.Sprintf("/sites/%s/forms", siteID), p.toQuery())
+	var res struct {
+		Forms []Form `json:"forms"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       path,
+		apiVersion: APIVersion2,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []Form{}, nil
+		}
+		return nil, err
+	}
+	return res.Forms, nil
+}
+
+// GetForm returns the form of the site with the given ID, including its fields. Forms
+// are fetched by ID alone, so a form the API reports as belonging to another site is
+// returned as an Error with a 404 code, like a form that doesn't exist.
+func (m *Webflow) GetForm(siteID, formID string) (*Form, error) {
+	return m.GetFormCtx(context.Background(), siteID, formID)
+}
+
+// GetFormCtx is like GetForm but uses ctx for the request.
+func (m *Webflow) GetFormCtx(ctx context.Context, siteID, formID string) (*Form, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if formID == "" {
+		return nil, ErrorMissingFormID
+	}
+	var form Form
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       fmt.Sprintf("/forms/%s", formID),
+		apiVersion: APIVersion2,
+	}, &form); err != nil {
+		return nil, err
+	}
+	if form.SiteID != "" && form.SiteID != siteID {
+		return nil, Error{Message: fmt.Sprintf("Form %s not found on site %s", formID, siteID), Code: http.StatusNotFound, Status: http.StatusNotFound}
+	}
+	return &form, nil
+}
+
+// ListFormSubmissions returns a page of submissions of the form along with the total
+// number of submissions.
+func (m *Webflow) ListFormSubmissions(formID string, p Param) ([]FormSubmission, int, error) {
+	return m.ListFormSubmissionsCtx(context.Background(), formID, p)
+}
+
+// ListFormSubmissionsCtx is like ListFormSubmissions but uses ctx for the request.
+func (m *Webflow) ListFormSubmissionsCtx(ctx context.Context, formID string, p Param) ([]FormSubmission, int, error) {
+	submissions, page, err := m.ListFormSubmissionsPageCtx(ctx, formID, p)
+	return submissions, page.Total, err
+}
+
+// ListFormSubmissionsPage returns a page of submissions of the form along with the
+// pagination metadata of the page.
+func (m *Webflow) ListFormSubmissionsPage(formID string, p Param) ([]FormSubmission, Pagination, error) {
+	return m.ListFormSubmissionsPageCtx(context.Background(), formID, p)
+}
+
+// ListFormSubmissionsPageCtx is like ListFormSubmissionsPage but uses ctx for the
+// request.
+func (m *Webflow) ListFormSubmissionsPageCtx(ctx context.Context, formID string, p Param) ([]FormSubmission, Pagination, error) {
+	if formID == "" {
+		return nil, Pagination{}, ErrorMissingFormID
+	}
+	path := withQuery(fmt.Sprintf("/forms/%s/submissions", formID), p.toQuery())
+	var res struct {
+		FormSubmissions []FormSubmission `json:"formSubmissions"`
+		listPage
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       path,
+		apiVersion: APIVersion2,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []FormSubmission{}, Pagination{}, nil
+		}
+		return nil, Pagination{}, err
+	}
+	return res.FormSubmissions, res.pagination(len(res.FormSubmissions)), nil
+}
+
+// DeleteFormSubmission deletes the form submission with the given ID. A submission
+// that doesn't exist is returned as an Error with a 404 code.
+func (m *Webflow) DeleteFormSubmission(submissionID string) error {
+	return m.DeleteFormSubmissionCtx(context.Background(), submissionID)
+}
+
+// DeleteFormSubmissionCtx is like DeleteFormSubmission but uses ctx for the request.
+func (m *Webflow) DeleteFormSubmissionCtx(ctx context.Context, submissionID string) error {
+	if submissionID == "" {
+		return ErrorMissingSubmissionID
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method:     http.MethodDelete,
+		path:       fmt.Sprintf("/form_submissions/%s", submissionID),
+		apiVersion: APIVersion2,
+	}, nil)
+}
@@ -0,0 +1,158 @@
+package webflow
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// submissionPages returns a handler serving total form submissions page by page in the
+// shape of the v2 API.
+func submissionPages(rec *recorder, total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var subs []string
+		for i := offset; i < total && i < offset+limit; i++ {
+			subs = append(subs, fmt.Sprintf(`{"id": "fs%d", "displayName": "Contact", "formId": "f1", "dateSubmitted": "2022-04-05T06:07:08.000Z", "formResponse": {"email": "user%d@example.com"}}`, i, i))
+		}
+		rec.reply(http.StatusOK, fmt.Sprintf(`{"formSubmissions": [%s], "pagination": {"limit": %d, "offset": %d, "total": %d}}`,
+			strings.Join(subs, ","), limit, offset, total))(w, r)
+	}
+}
+
+func TestListFormSubmissions(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, submissionPages(&rec, 3))
+
+	first, total, err := m.ListFormSubmissions("f1", Param{Page: 1, PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListFormSubmissions page 1: %v", err)
+	}
+	second, _, err := m.ListFormSubmissions("f1", Param{Page: 2, PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListFormSubmissions page 2: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("total = %d, want 3", total)
+	}
+	if len(first) != 2 || len(second) != 1 {
+		t.Fatalf("got pages of %d and %d submissions, want 2 and 1", len(first), len(second))
+	}
+	reqs := rec.requests()
+	for i, r := range reqs {
+		assertRequest(t, r, http.MethodGet, "/v2/forms/f1/submissions")
+		if got, want := r.Query.Get("offset"), []string{"", "2"}[i]; got != want {
+			t.Errorf("page %d offset = %q, want %q", i+1, got, want)
+		}
+	}
+	s := second[0]
+	if s.ID != "fs2" || s.FormID != "f1" || s.FormResponse["email"] != "user2@example.com" {
+		t.Errorf("unexpected submission %+v", s)
+	}
+	if want := time.Date(2022, 4, 5, 6, 7, 8, 0, time.UTC); !s.SubmittedAt.Equal(want) {
+		t.Errorf("SubmittedAt = %v, want %v", s.SubmittedAt, want)
+	}
+}
+
+func TestDeleteFormSubmission(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := m.DeleteFormSubmission("fs1"); err != nil {
+		t.Fatalf("DeleteFormSubmission: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodDelete, "/v2/form_submissions/fs1")
+}
+
+func TestDeleteFormSubmissionNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, `{"code": "resource_not_found", "message": "Requested resource not found"}`))
+
+	var e Error
+	if err := m.DeleteFormSubmission("fs1"); !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("DeleteFormSubmission error = %#v, want a 404 Error", err)
+	}
+}
+
+// formBody is a form with two fields as the v2 API returns it.
+const formBody = `{
+	"id": "f1", "siteId": "s1", "displayName": "Contact", "pageId": "pg1", "pageName": "Home",
+	"fields": {
+		"b2": {"displayName": "Message", "type": "Plain", "placeholder": "Say hi", "userVisible": true},
+		"a1": {"displayName": "Email", "type": "Email", "placeholder": "you@example.com", "userVisible": true}
+	}
+}`
+
+// formFields are the fields of formBody, ordered by ID.
+var formFields = []FormField{
+	{ID: "a1", DisplayName: "Email", Type: "Email", Placeholder: "you@example.com", UserVisible: true},
+	{ID: "b2", DisplayName: "Message", Type: "Plain", Placeholder: "Say hi", UserVisible: true},
+}
+
+func TestListForms(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"forms": [`+formBody+`, {"id": "f2", "displayName": "Newsletter", "fields": {}}], "pagination": {"limit": 2, "offset": 2, "total": 4}}`))
+
+	forms, err := m.ListForms("s1", Param{Page: 2, PerPage: 2})
+	if err != nil {
+		t.Fatalf("ListForms: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodGet, "/v2/sites/s1/forms")
+	if got := r.Query.Encode(); got != "limit=2&offset=2" {
+		t.Errorf("query = %q, want limit=2&offset=2", got)
+	}
+	if len(forms) != 2 {
+		t.Fatalf("got %d forms, want 2", len(forms))
+	}
+	if f := forms[0]; f.ID != "f1" || f.DisplayName != "Contact" || f.PageID != "pg1" || f.PageName != "Home" || !reflect.DeepEqual(f.Fields, formFields) {
+		t.Errorf("unexpected form %+v", f)
+	}
+	if f := forms[1]; f.ID != "f2" || len(f.Fields) != 0 {
+		t.Errorf("unexpected form %+v", f)
+	}
+}
+
+func TestGetForm(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, formBody))
+
+	form, err := m.GetForm("s1", "f1")
+	if err != nil {
+		t.Fatalf("GetForm: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/v2/forms/f1")
+	if form.ID != "f1" || form.SiteID != "s1" || !reflect.DeepEqual(form.Fields, formFields) {
+		t.Errorf("unexpected form %+v", form)
+	}
+
+	_, err = m.GetForm("s2", "f1")
+	var e *Error
+	if !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("GetForm of another site's form error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestGetFormMissingIDs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, formBody))
+
+	if _, err := m.GetForm("", "f1"); err != ErrorMissingSiteID {
+		t.Errorf("GetForm without a site error = %v, want %v", err, ErrorMissingSiteID)
+	}
+	if _, err := m.GetForm("s1", ""); err != ErrorMissingFormID {
+		t.Errorf("GetForm without a form error = %v, want %v", err, ErrorMissingFormID)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
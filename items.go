@@ -0,0 +1,555 @@
+package webflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxBatchSize is the maximum number of item IDs the API accepts per bulk request.
+const maxBatchSize = 100
+
+// Item defines a CMS item of a collection.
+type Item struct {
+	ID           string    `json:"_id"`
+	CollectionID string    `json:"_cid"`
+	Slug         string    `json:"slug"`
+	CreatedOn    time.Time `json:"created-on"`
+	Updated      time.Time `json:"updated-on"`
+	Published    time.Time `json:"published-on"`
+	Draft        bool      `json:"_draft"`
+	Archived     bool      `json:"_archived"`
+	// Fields holds every field of the item keyed by slug, including the ones above.
+	// Numbers are held as json.Number; use its Int64 or Float64 method to read them.
+	Fields map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON decodes the standard item fields as well as the dynamic CMS fields.
+func (i *Item) UnmarshalJSON(b []byte) error {
+	type item Item
+	var it item
+	if err := decodeFields(b, &it, &it.Fields); err != nil {
+		return err
+	}
+	*i = Item(it)
+	return nil
+}
+
+// decodeFields decodes b into v as well as into the fields map, for objects that carry
+// dynamic CMS fields next to their standard ones. Numbers in the fields map are kept as
+// json.Number so that large integers don't lose precision as a float64.
+func decodeFields(b []byte, v interface{}, fields *map[string]interface{}) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(fields)
+}
+
+// ListItems returns a page of items of the collection along with the total number of
+// items in the collection.
+func (m *Webflow) ListItems(collectionID string, p Param) ([]Item, int, error) {
+	return m.ListItemsCtx(context.Background(), collectionID, p)
+}
+
+// ListItemsCtx is like ListItems but uses ctx for the request.
+func (m *Webflow) ListItemsCtx(ctx context.Context, collectionID string, p Param) ([]Item, int, error) {
+	items, page, err := m.ListItemsPageCtx(ctx, collectionID, p)
+	return items, page.Total, err
+}
+
+// ListItemsPage returns a page of items of the collection along with the pagination
+// metadata of the page. A collection without items is returned as an empty page.
+func (m *Webflow) ListItemsPage(collectionID string, p Param) ([]Item, Pagination, error) {
+	return m.ListItemsPageCtx(context.Background(), collectionID, p)
+}
+
+// ListItemsPageCtx is like ListItemsPage but uses ctx for the request.
+func (m *Webflow) ListItemsPageCtx(ctx context.Context, collectionID string, p Param) ([]Item, Pagination, error) {
+	if collectionID == "" {
+		return nil, Pagination{}, ErrorMissingCollectionID
+	}
+	path := withQuery(fmt.Sprintf("/collections/%s/items", collectionID), p.toQuery())
+	var res struct {
+		Items []Item `json:"items"`
+		listPage
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   path,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []Item{}, Pagination{}, nil
+		}
+		return nil, Pagination{}, err
+	}
+	return res.Items, res.pagination(len(res.Items)), nil
+}
+
+// CountItems returns the total number of items in the collection, fetching a single
+// item to read the total from.
+func (m *Webflow) CountItems(collectionID string) (int, error) {
+	return m.CountItemsCtx(context.Background(), collectionID)
+}
+
+// CountItemsCtx is like CountItems but uses ctx for the request.
+func (m *Webflow) CountItemsCtx(ctx context.Context, collectionID string) (int, error) {
+	_, page, err := m.ListItemsPageCtx(ctx, collectionID, Param{PerPage: 1})
+	return page.Total, err
+}
+
+// GetItem returns the item with the given ID. An item that doesn't exist is returned
+// as an Error with a 404 code.
+func (m *Webflow) GetItem(collectionID, itemID string) (*Item, error) {
+	return m.GetItemCtx(context.Background(), collectionID, itemID)
+}
+
+// GetItemCtx is like GetItem but uses ctx for the request.
+func (m *Webflow) GetItemCtx(ctx context.Context, collectionID, itemID string) (*Item, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	if itemID == "" {
+		return nil, ErrorMissingItemID
+	}
+	var res struct {
+		Items []Item `json:"items"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/collections/%s/items/%s", collectionID, itemID),
+	}, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Items) == 0 {
+		return nil, Error{Message: fmt.Sprintf("Item %s not found", itemID), Code: http.StatusNotFound, Status: http.StatusNotFound}
+	}
+	return &res.Items[0], nil
+}
+
+// GetItemBySlug returns the item of the collection with the given slug, paging through
+// the collection until it's found. An item that doesn't exist is returned as an Error
+// with a 404 code.
+func (m *Webflow) GetItemBySlug(collectionID, slug string) (*Item, error) {
+	return m.GetItemBySlugCtx(context.Background(), collectionID, slug)
+}
+
+// GetItemBySlugCtx is like GetItemBySlug but uses ctx for the requests.
+func (m *Webflow) GetItemBySlugCtx(ctx context.Context, collectionID, slug string) (*Item, error) {
+	it := m.ItemIteratorCtx(ctx, collectionID, maxPerPage)
+	for it.Next() {
+		if item := it.Item(); item.Slug == slug {
+			return &item, nil
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return nil, Error{Message: fmt.Sprintf("Item with slug %s not found", slug), Code: http.StatusNotFound, Status: http.StatusNotFound}
+}
+
+// withLive appends the live query parameter to path when live is true, publishing the
+// change immediately instead of leaving it as a draft.
+func withLive(path string, live bool) string {
+	if live {
+		return path + "?live=true"
+	}
+	return path
+}
+
+// CreateItem creates an item in the collection with the given fields, which must
+// include a name and a slug. When live is true the item is published immediately,
+// otherwise it's created as a draft.
+func (m *Webflow) CreateItem(collectionID string, fields map[string]interface{}, live bool) (*Item, error) {
+	return m.CreateItemCtx(context.Background(), collectionID, fields, live)
+}
+
+// CreateItemCtx is like CreateItem but uses ctx for the request.
+func (m *Webflow) CreateItemCtx(ctx context.Context, collectionID string, fields map[string]interface{}, live bool) (*Item, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	for _, k := range []string{"name", "slug"} {
+		if _, ok := fields[k]; !ok {
+			return nil, fmt.Errorf("missing webflow item field %q", k)
+		}
+	}
+	var item Item
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodPost,
+		path:   withLive(fmt.Sprintf("/collections/%s/items", collectionID), live),
+		data: map[string]interface{}{
+			"fields": fields,
+		},
+		idempotencyKey: newIdempotencyKey(),
+	}, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// CreateItems creates the items in the collection, running up to concurrency CreateItem
+// calls at a time. The created items and the errors are aligned by index with items;
+// the errors are nil when all items were created. Enable AutoThrottle to keep the
+// workers within the rate limit.
+func (m *Webflow) CreateItems(collectionID string, items []map[string]interface{}, live bool, concurrency int) ([]Item, []error) {
+	return m.CreateItemsCtx(context.Background(), collectionID, items, live, concurrency)
+}
+
+// CreateItemsCtx is like CreateItems but uses ctx for the requests.
+func (m *Webflow) CreateItemsCtx(ctx context.Context, collectionID string, items []map[string]interface{}, live bool, concurrency int) ([]Item, []error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	created := make([]Item, len(items))
+	errs := make([]error, len(items))
+	var wg sync.WaitGroup
+	indexes := make(chan int)
+	for w := 0; w < concurrency && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				item, err := m.CreateItemCtx(ctx, collectionID, items[i], live)
+				if err != nil {
+					errs[i] = err
+					continue
+				}
+				created[i] = *item
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return created, errs
+		}
+	}
+	return created, nil
+}
+
+// UpdateItem replaces all fields of the item with the given fields. Any field omitted
+// from fields is cleared; use PatchItem to change only some of them.
+func (m *Webflow) UpdateItem(collectionID, itemID string, fields map[string]interface{}, live bool) (*Item, error) {
+	return m.UpdateItemCtx(context.Background(), collectionID, itemID, fields, live)
+}
+
+// UpdateItemCtx is like UpdateItem but uses ctx for the request.
+func (m *Webflow) UpdateItemCtx(ctx context.Context, collectionID, itemID string, fields map[string]interface{}, live bool) (*Item, error) {
+	return m.writeItem(ctx, http.MethodPut, collectionID, itemID, fields, live)
+}
+
+// PatchItem changes only the given fields of the item, leaving all other fields as
+// they are.
+func (m *Webflow) PatchItem(collectionID, itemID string, fields map[string]interface{}, live bool) (*Item, error) {
+	return m.PatchItemCtx(context.Background(), collectionID, itemID, fields, live)
+}
+
+// PatchItemCtx is like PatchItem but uses ctx for the request.
+func (m *Webflow) PatchItemCtx(ctx context.Context, collectionID, itemID string, fields map[string]interface{}, live bool) (*Item, error) {
+	return m.writeItem(ctx, http.MethodPatch, collectionID, itemID, fields, live)
+}
+
+// ArchiveItem archives the item, hiding it from the site without deleting it.
+func (m *Webflow) ArchiveItem(collectionID, itemID string, live bool) (*Item, error) {
+	return m.ArchiveItemCtx(context.Background(), collectionID, itemID, live)
+}
+
+// ArchiveItemCtx is like ArchiveItem but uses ctx for the request.
+func (m *Webflow) ArchiveItemCtx(ctx context.Context, collectionID, itemID string, live bool) (*Item, error) {
+	return m.PatchItemCtx(ctx, collectionID, itemID, map[string]interface{}{"_archived": true}, live)
+}
+
+// UnarchiveItem restores the archived item.
+func (m *Webflow) UnarchiveItem(collectionID, itemID string, live bool) (*Item, error) {
+	return m.UnarchiveItemCtx(context.Background(), collectionID, itemID, live)
+}
+
+// UnarchiveItemCtx is like UnarchiveItem but uses ctx for the request.
+func (m *Webflow) UnarchiveItemCtx(ctx context.Context, collectionID, itemID string, live bool) (*Item, error) {
+	return m.PatchItemCtx(ctx, collectionID, itemID, map[string]interface{}{"_archived": false}, live)
+}
+
+// SetItemImageFromURL sets the Image field of the item with the given slug to the
+// image hosted at url, leaving all other fields as they are.
+func (m *Webflow) SetItemImageFromURL(collectionID, itemID, fieldSlug, url string, live bool) (*Item, error) {
+	return m.SetItemImageFromURLCtx(context.Background(), collectionID, itemID, fieldSlug, url, live)
+}
+
+// SetItemImageFromURLCtx is like SetItemImageFromURL but uses ctx for the request.
+func (m *Webflow) SetItemImageFromURLCtx(ctx context.Context, collectionID, itemID, fieldSlug, url string, live bool) (*Item, error) {
+	if fieldSlug == "" {
+		return nil, errors.New("missing webflow item field slug")
+	}
+	if url == "" {
+		return nil, errors.New("missing webflow image url")
+	}
+	return m.PatchItemCtx(ctx, collectionID, itemID, map[string]interface{}{fieldSlug: ImageField(url)}, live)
+}
+
+// writeItem sends the fields of an existing item using the given method.
+func (m *Webflow) writeItem(ctx context.Context, method, collectionID, itemID string, fields map[string]interface{}, live bool) (*Item, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	if itemID == "" {
+		return nil, ErrorMissingItemID
+	}
+	var item Item
+	if err := m.requestCtx(ctx, clientRequest{
+		method: method,
+		path:   withLive(fmt.Sprintf("/collections/%s/items/%s", collectionID, itemID), live),
+		data: map[string]interface{}{
+			"fields": fields,
+		},
+	}, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// DeleteItem deletes the item with the given ID.
+func (m *Webflow) DeleteItem(collectionID, itemID string) error {
+	return m.DeleteItemCtx(context.Background(), collectionID, itemID)
+}
+
+// DeleteItemCtx is like DeleteItem but uses ctx for the request.
+func (m *Webflow) DeleteItemCtx(ctx context.Context, collectionID, itemID string) error {
+	if collectionID == "" {
+		return ErrorMissingCollectionID
+	}
+	if itemID == "" {
+		return ErrorMissingItemID
+	}
+	var res struct {
+		Deleted int `json:"deleted"`
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/collections/%s/items/%s", collectionID, itemID),
+	}, &res)
+}
+
+// DeleteItems deletes the items with the given IDs, sending them in batches the API
+// accepts. It stops at and returns the first error encountered.
+func (m *Webflow) DeleteItems(collectionID string, itemIDs []string) error {
+	return m.DeleteItemsCtx(context.Background(), collectionID, itemIDs)
+}
+
+// DeleteItemsCtx is like DeleteItems but uses ctx for the request.
+func (m *Webflow) DeleteItemsCtx(ctx context.Context, collectionID string, itemIDs []string) error {
+	if collectionID == "" {
+		return ErrorMissingCollectionID
+	}
+	for _, ids := range batches(itemIDs, maxBatchSize) {
+		var res struct {
+			Deleted int `json:"deleted"`
+		}
+		if err := m.requestCtx(ctx, clientRequest{
+			method: http.MethodDelete,
+			path:   fmt.Sprintf("/collections/%s/items", collectionID),
+			data: map[string]interface{}{
+				"itemIds": ids,
+			},
+		}, &res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishItems publishes the draft items with the given IDs, sending them in batches
+// the API accepts, and returns the IDs of the items that were published.
+func (m *Webflow) PublishItems(collectionID string, itemIDs []string) ([]string, error) {
+	return m.PublishItemsCtx(context.Background(), collectionID, itemIDs)
+}
+
+// PublishItemsCtx is like PublishItems but uses ctx for the request.
+func (m *Webflow) PublishItemsCtx(ctx context.Context, collectionID string, itemIDs []string) ([]string, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	var published []string
+	for _, ids := range batches(itemIDs, maxBatchSize) {
+		var res struct {
+			PublishedItemIDs []string `json:"publishedItemIds"`
+		}
+		if err := m.requestCtx(ctx, clientRequest{
+			method: http.MethodPut,
+			path:   fmt.Sprintf("/collections/%s/items/publish", collectionID),
+			data: map[string]interface{}{
+				"itemIds": ids,
+			},
+		}, &res); err != nil {
+			return published, err
+		}
+		published = append(published, res.PublishedItemIDs...)
+	}
+	return published, nil
+}
+
+// PublishItem publishes the draft item with the given ID. An item the API doesn't
+// report as published is returned as an error.
+func (m *Webflow) PublishItem(collectionID, itemID string) error {
+	return m.PublishItemCtx(context.Background(), collectionID, itemID)
+}
+
+// PublishItemCtx is like PublishItem but uses ctx for the request.
+func (m *Webflow) PublishItemCtx(ctx context.Context, collectionID, itemID string) error {
+	if itemID == "" {
+		return ErrorMissingItemID
+	}
+	published, err := m.PublishItemsCtx(ctx, collectionID, []string{itemID})
+	if err != nil {
+		return err
+	}
+	for _, id := range published {
+		if id == itemID {
+			return nil
+		}
+	}
+	return Error{Message: fmt.Sprintf("Item %s was not published", itemID), Code: defaultCode}
+}
+
+// UnpublishItems removes the items with the given IDs from the live site while keeping
+// them in the collection, unlike DeleteItems which removes the items altogether. The
+// IDs are sent in batches the API accepts.
+func (m *Webflow) UnpublishItems(collectionID string, itemIDs []string) error {
+	return m.UnpublishItemsCtx(context.Background(), collectionID, itemIDs)
+}
+
+// UnpublishItemsCtx is like UnpublishItems but uses ctx for the requests.
+func (m *Webflow) UnpublishItemsCtx(ctx context.Context, collectionID string, itemIDs []string) error {
+	if collectionID == "" {
+		return ErrorMissingCollectionID
+	}
+	cr := withContextSettings(ctx, clientRequest{
+		method: http.MethodDelete,
+		path:   withLive(fmt.Sprintf("/collections/%s/items", collectionID), true),
+	})
+	if m.apiVersion(cr) == APIVersion2 {
+		cr.path = fmt.Sprintf("/collections/%s/items/publish", collectionID)
+	}
+	for _, ids := range batches(itemIDs, maxBatchSize) {
+		cr.data = map[string]interface{}{
+			"itemIds": ids,
+		}
+		if err := m.requestCtx(ctx, cr, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batches splits ids into consecutive slices of at most size elements.
+func batches(ids []string, size int) [][]string {
+	var b [][]string
+	for len(ids) > size {
+		b = append(b, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		b = append(b, ids)
+	}
+	return b
+}
+
+// ItemIterator iterates over all items of a collection, fetching pages as needed.
+type ItemIterator struct {
+	m            *Webflow
+	ctx          context.Context
+	collectionID string
+	perPage      int
+	page         int
+	items        []Item
+	index        int
+	fetched      int
+	done         bool
+	err          error
+}
+
+// ItemIterator returns an iterator over all items of the collection, fetching perPage
+// items at a time.
+func (m *Webflow) ItemIterator(collectionID string, perPage int) *ItemIterator {
+	return m.ItemIteratorCtx(context.Background(), collectionID, perPage)
+}
+
+// ItemIteratorCtx is like ItemIterator but uses ctx for the requests.
+func (m *Webflow) ItemIteratorCtx(ctx context.Context, collectionID string, perPage int) *ItemIterator {
+	if perPage <= 0 || perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	return &ItemIterator{
+		m:            m,
+		ctx:          ctx,
+		collectionID: collectionID,
+		perPage:      perPage,
+		index:        -1,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page when the
+// current one is exhausted. It returns false when there are no more items or an error
+// occurred.
+func (it *ItemIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	if it.index < len(it.items) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+	it.page++
+	items, total, err := it.m.ListItemsCtx(it.ctx, it.collectionID, Param{Page: it.page, PerPage: it.perPage})
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.items, it.index = items, 0
+	it.fetched += len(items)
+	it.done = it.fetched >= total || len(items) == 0
+	return len(items) > 0
+}
+
+// Item returns the current item.
+func (it *ItemIterator) Item() Item {
+	return it.items[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ItemIterator) Err() error {
+	return it.err
+}
+
+// ListAllItems returns every item of the collection, fetching as many pages of
+// maxPerPage items as needed. Enable AutoThrottle to keep large collections within the
+// rate limit.
+func (m *Webflow) ListAllItems(collectionID string) ([]Item, error) {
+	return m.ListAllItemsCtx(context.Background(), collectionID)
+}
+
+// ListAllItemsCtx is like ListAllItems but uses ctx for the requests.
+func (m *Webflow) ListAllItemsCtx(ctx context.Context, collectionID string) ([]Item, error) {
+	var items []Item
+	it := m.ItemIteratorCtx(ctx, collectionID, maxPerPage)
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
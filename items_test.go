@@ -0,0 +1,533 @@
+package webflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const itemBody = `{"_id": "i1", "_cid": "c1", "slug": "first", "name": "First", "_draft": false, "_archived": false}`
+
+func TestUpdateItem(t *testing.T) {
+	for _, live := range []bool{false, true} {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, itemBody))
+
+		item, err := m.UpdateItem("c1", "i1", map[string]interface{}{"name": "First", "slug": "first"}, live)
+		if err != nil {
+			t.Fatalf("UpdateItem(live=%v): %v", live, err)
+		}
+		if item.ID != "i1" {
+			t.Errorf("item ID = %q, want i1", item.ID)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPut, "/collections/c1/items/i1")
+		if got := r.Query.Get("live"); (got == "true") != live {
+			t.Errorf("live=%v: live query parameter = %q", live, got)
+		}
+		want := map[string]interface{}{"fields": map[string]interface{}{"name": "First", "slug": "first"}}
+		if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+			t.Errorf("body = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestPatchItem(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, itemBody))
+
+	if _, err := m.PatchItem("c1", "i1", map[string]interface{}{"featured": true}, true); err != nil {
+		t.Fatalf("PatchItem: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPatch, "/collections/c1/items/i1")
+	if got := r.Query.Get("live"); got != "true" {
+		t.Errorf("live query parameter = %q, want true", got)
+	}
+	want := map[string]interface{}{"fields": map[string]interface{}{"featured": true}}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+}
+
+func TestDeleteItem(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"deleted": 1}`))
+
+	if err := m.DeleteItem("c1", "i1"); err != nil {
+		t.Fatalf("DeleteItem: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodDelete, "/collections/c1/items/i1")
+}
+
+func TestDeleteItems(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"deleted": 100}`))
+
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("i%d", i)
+	}
+	if err := m.DeleteItems("c1", ids); err != nil {
+		t.Fatalf("DeleteItems: %v", err)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 3 {
+		t.Fatalf("%d requests were made, want 3", len(reqs))
+	}
+	var sent []string
+	for i, r := range reqs {
+		assertRequest(t, r, http.MethodDelete, "/collections/c1/items")
+		batch := r.jsonBody(t)["itemIds"].([]interface{})
+		if want := []int{100, 100, 50}[i]; len(batch) != want {
+			t.Errorf("batch %d has %d IDs, want %d", i, len(batch), want)
+		}
+		for _, id := range batch {
+			sent = append(sent, id.(string))
+		}
+	}
+	if !reflect.DeepEqual(sent, ids) {
+		t.Errorf("sent IDs %v, want %v", sent, ids)
+	}
+}
+
+func TestDeleteItemsStopsAtFirstError(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusBadRequest, `{"msg": "Invalid item ID", "code": 400, "name": "ValidationError"}`))
+
+	err := m.DeleteItems("c1", make([]string, 150))
+	var e Error
+	if !errors.As(err, &e) || e.Name != "ValidationError" || e.Status != http.StatusBadRequest {
+		t.Fatalf("DeleteItems error = %#v, want a ValidationError", err)
+	}
+	if n := len(rec.requests()); n != 1 {
+		t.Errorf("%d requests were made, want 1", n)
+	}
+}
+
+// itemPages returns a handler serving total items page by page according to the limit
+// and offset parameters.
+func itemPages(rec *recorder, total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var items []string
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, fmt.Sprintf(`{"_id": "i%d", "slug": "item-%d"}`, i, i))
+		}
+		rec.reply(http.StatusOK, fmt.Sprintf(`{"items": [%s], "count": %d, "limit": %d, "offset": %d, "total": %d}`,
+			strings.Join(items, ","), len(items), limit, offset, total))(w, r)
+	}
+}
+
+func TestItemIterator(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, itemPages(&rec, 7))
+
+	var ids []string
+	it := m.ItemIterator("c1", 3)
+	for it.Next() {
+		ids = append(ids, it.Item().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if want := []string{"i0", "i1", "i2", "i3", "i4", "i5", "i6"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("iterated over %v, want %v", ids, want)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 3 {
+		t.Fatalf("%d requests were made, want 3", len(reqs))
+	}
+	for i, r := range reqs {
+		assertRequest(t, r, http.MethodGet, "/collections/c1/items")
+		if got, want := r.Query.Get("offset"), []string{"", "3", "6"}[i]; got != want || r.Query.Get("limit") != "3" {
+			t.Errorf("page %d requested with limit %q offset %q, want limit 3 offset %q", i+1, r.Query.Get("limit"), got, want)
+		}
+	}
+}
+
+func TestItemIteratorError(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusBadRequest, `{"msg": "Bad request", "code": 400}`))
+
+	it := m.ItemIterator("c1", 3)
+	if it.Next() {
+		t.Error("Next() = true on a failing request")
+	}
+	if it.Err() == nil {
+		t.Error("Err() = nil on a failing request")
+	}
+}
+
+func TestCreateItemsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(20 * time.Millisecond)
+
+		var body struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if body.Fields["name"] == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"msg": "Validation Failure", "code": 400}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"_id": "id-" + body.Fields["slug"].(string), "name": body.Fields["name"]})
+	})
+
+	var items []map[string]interface{}
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("item %d", i)
+		if i == 4 {
+			name = "bad"
+		}
+		items = append(items, map[string]interface{}{"name": name, "slug": fmt.Sprintf("s%d", i)})
+	}
+	created, errs := m.CreateItems("c1", items, false, 3)
+	if len(created) != len(items) || len(errs) != len(items) {
+		t.Fatalf("got %d items and %d errors, want %d of each", len(created), len(errs), len(items))
+	}
+	for i := range items {
+		if i == 4 {
+			if errs[i] == nil {
+				t.Errorf("item %d: no error for a failing create", i)
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("item %d: %v", i, errs[i])
+		}
+		if want := fmt.Sprintf("id-s%d", i); created[i].ID != want {
+			t.Errorf("item %d has ID %q, want %q", i, created[i].ID, want)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if maxInFlight > 3 || maxInFlight < 2 {
+		t.Errorf("%d requests were in flight at once, want between 2 and 3", maxInFlight)
+	}
+}
+
+func TestBatches(t *testing.T) {
+	ids := make([]string, 201)
+	var sizes []int
+	for _, b := range batches(ids, maxBatchSize) {
+		sizes = append(sizes, len(b))
+	}
+	if want := []int{100, 100, 1}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("batch sizes = %v, want %v", sizes, want)
+	}
+	if b := batches(nil, maxBatchSize); len(b) != 0 {
+		t.Errorf("batches(nil) = %v, want none", b)
+	}
+	if b := batches(make([]string, 100), maxBatchSize); len(b) != 1 {
+		t.Errorf("100 IDs were split into %d batches, want 1", len(b))
+	}
+}
+
+func TestPublishItems(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ItemIDs []string `json:"itemIds"`
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(b))
+		json.Unmarshal(b, &body)
+		res, _ := json.Marshal(map[string]interface{}{"publishedItemIds": body.ItemIDs})
+		rec.reply(http.StatusOK, string(res))(w, r)
+	})
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("i%d", i)
+	}
+	published, err := m.PublishItems("c1", ids)
+	if err != nil {
+		t.Fatalf("PublishItems: %v", err)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 2 {
+		t.Fatalf("%d requests were made, want 2", len(reqs))
+	}
+	for i, r := range reqs {
+		assertRequest(t, r, http.MethodPut, "/collections/c1/items/publish")
+		if n, want := len(r.jsonBody(t)["itemIds"].([]interface{})), []int{100, 50}[i]; n != want {
+			t.Errorf("batch %d has %d IDs, want %d", i, n, want)
+		}
+	}
+	if !reflect.DeepEqual(published, ids) {
+		t.Errorf("published %d IDs %v..., want the 150 IDs in order", len(published), published[:3])
+	}
+}
+
+func TestUnpublishItems(t *testing.T) {
+	tests := []struct {
+		version APIVersion
+		path    string
+		live    string
+	}{
+		{APIVersion1, "/collections/c1/items", "true"},
+		{APIVersion2, "/v2/collections/c1/items/publish", ""},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{}`), WithAPIVersion(tt.version))
+
+		if err := m.UnpublishItems("c1", make([]string, 150)); err != nil {
+			t.Fatalf("UnpublishItems with API version %d: %v", tt.version, err)
+		}
+		reqs := rec.requests()
+		if len(reqs) != 2 {
+			t.Fatalf("%d requests were made, want 2", len(reqs))
+		}
+		for i, r := range reqs {
+			assertRequest(t, r, http.MethodDelete, tt.path)
+			if got := r.Query.Get("live"); got != tt.live {
+				t.Errorf("API version %d: live = %q, want %q", tt.version, got, tt.live)
+			}
+			if n, want := len(r.jsonBody(t)["itemIds"].([]interface{})), []int{100, 50}[i]; n != want {
+				t.Errorf("batch %d has %d IDs, want %d", i, n, want)
+			}
+		}
+	}
+}
+
+func TestUnpublishItemsRequestAPIVersion(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	ctx := WithRequestAPIVersion(context.Background(), APIVersion2)
+	if err := m.UnpublishItemsCtx(ctx, "c1", []string{"i1"}); err != nil {
+		t.Fatalf("UnpublishItemsCtx: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodDelete, "/v2/collections/c1/items/publish")
+	if got := r.Query.Get("live"); got != "" {
+		t.Errorf("live = %q on v2, want none", got)
+	}
+}
+
+func TestListItemsPage(t *testing.T) {
+	tests := []struct {
+		version APIVersion
+		body    string
+	}{
+		{APIVersion1, `{"items": [{"_id": "i1"}, {"_id": "i2"}], "count": 2, "limit": 2, "offset": 4, "total": 9}`},
+		{APIVersion2, `{"items": [{"id": "i1"}, {"id": "i2"}], "pagination": {"limit": 2, "offset": 4, "total": 9}}`},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, tt.body), WithAPIVersion(tt.version))
+
+		items, page, err := m.ListItemsPage("c1", Param{Page: 3, PerPage: 2})
+		if err != nil {
+			t.Fatalf("ListItemsPage with API version %d: %v", tt.version, err)
+		}
+		if len(items) != 2 {
+			t.Errorf("API version %d: got %d items, want 2", tt.version, len(items))
+		}
+		if want := (Pagination{Count: 2, Limit: 2, Offset: 4, Total: 9}); page != want {
+			t.Errorf("API version %d: pagination = %+v, want %+v", tt.version, page, want)
+		}
+	}
+}
+
+func TestListAllItems(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, itemPages(&rec, 250))
+
+	items, err := m.ListAllItems("c1")
+	if err != nil {
+		t.Fatalf("ListAllItems: %v", err)
+	}
+	if len(items) != 250 || items[0].ID != "i0" || items[249].ID != "i249" {
+		t.Errorf("got %d items, want all 250 in order", len(items))
+	}
+	reqs := rec.requests()
+	if len(reqs) != 3 {
+		t.Fatalf("%d requests were made, want 3", len(reqs))
+	}
+	for i, r := range reqs {
+		if got, want := r.Query.Get("offset"), []string{"", "100", "200"}[i]; got != want || r.Query.Get("limit") != "100" {
+			t.Errorf("page %d requested with limit %q offset %q, want limit 100 offset %q", i+1, r.Query.Get("limit"), got, want)
+		}
+	}
+}
+
+func TestGetItemBySlug(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, itemPages(&rec, 150))
+
+	item, err := m.GetItemBySlug("c1", "item-120")
+	if err != nil {
+		t.Fatalf("GetItemBySlug: %v", err)
+	}
+	if item.ID != "i120" {
+		t.Errorf("item ID = %q, want i120", item.ID)
+	}
+	if n := len(rec.requests()); n != 2 {
+		t.Errorf("%d requests were made, want 2", n)
+	}
+}
+
+func TestGetItemBySlugNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, itemPages(&rec, 150))
+
+	_, err := m.GetItemBySlug("c1", "missing")
+	var e Error
+	if !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("GetItemBySlug error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestItemLargeNumberRoundTrip(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"items": [{"_id": "i1", "_cid": "c1", "slug": "first", "name": "First", "external-id": 9007199254740993, "rating": 4.5}]}`))
+
+	item, err := m.GetItem("c1", "i1")
+	if err != nil {
+		t.Fatalf("GetItem: %v", err)
+	}
+	n, ok := item.Fields["external-id"].(json.Number)
+	if !ok || n.String() != "9007199254740993" {
+		t.Fatalf("external-id = %#v, want json.Number 9007199254740993", item.Fields["external-id"])
+	}
+	if i, err := n.Int64(); err != nil || i != 9007199254740993 {
+		t.Errorf("Int64() = %d, %v, want 9007199254740993", i, err)
+	}
+	if f, err := item.Fields["rating"].(json.Number).Float64(); err != nil || f != 4.5 {
+		t.Errorf("rating = %v, %v, want 4.5", f, err)
+	}
+
+	if _, err := m.UpdateItem("c1", "i1", item.Fields, false); err != nil {
+		t.Fatalf("UpdateItem: %v", err)
+	}
+	if body := string(rec.last(t).Body); !strings.Contains(body, `"external-id":9007199254740993`) {
+		t.Errorf("the number didn't survive the round trip: %s", body)
+	}
+}
+
+func TestCountItems(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, itemPages(&rec, 1234))
+
+	count, err := m.CountItems("c1")
+	if err != nil {
+		t.Fatalf("CountItems: %v", err)
+	}
+	if count != 1234 {
+		t.Errorf("count = %d, want 1234", count)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 1 {
+		t.Fatalf("%d requests were made, want 1", len(reqs))
+	}
+	if got := reqs[0].Query.Get("limit"); got != "1" {
+		t.Errorf("limit = %q, want 1", got)
+	}
+}
+
+func TestPublishItem(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"publishedItemIds": ["i1"]}`))
+
+	if err := m.PublishItem("c1", "i1"); err != nil {
+		t.Fatalf("PublishItem: %v", err)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 1 {
+		t.Fatalf("%d requests were made, want 1", len(reqs))
+	}
+	assertRequest(t, reqs[0], http.MethodPut, "/collections/c1/items/publish")
+	if got, want := reqs[0].jsonBody(t)["itemIds"], []interface{}{"i1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("itemIds = %v, want %v", got, want)
+	}
+}
+
+func TestPublishItemNotPublished(t *testing.T) {
+	for _, body := range []string{`{"publishedItemIds": []}`, `{"publishedItemIds": ["i2"]}`, `{}`} {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, body))
+
+		if err := m.PublishItem("c1", "i1"); err == nil {
+			t.Errorf("PublishItem succeeded with the response %s", body)
+		}
+	}
+}
+
+func TestArchiveItem(t *testing.T) {
+	tests := []struct {
+		archive func(m *Webflow) (*Item, error)
+		want    bool
+	}{
+		{func(m *Webflow) (*Item, error) { return m.ArchiveItem("c1", "i1", false) }, true},
+		{func(m *Webflow) (*Item, error) { return m.UnarchiveItem("c1", "i1", false) }, false},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, itemBody))
+
+		if _, err := tt.archive(m); err != nil {
+			t.Fatalf("archiving to %v: %v", tt.want, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPatch, "/collections/c1/items/i1")
+		want := map[string]interface{}{"fields": map[string]interface{}{"_archived": tt.want}}
+		if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+			t.Errorf("body = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSetItemImageFromURL(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, itemBody))
+
+	if _, err := m.SetItemImageFromURL("c1", "i1", "hero", "https://cdn.example.com/a.png", true); err != nil {
+		t.Fatalf("SetItemImageFromURL: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPatch, "/collections/c1/items/i1")
+	want := map[string]interface{}{"fields": map[string]interface{}{
+		"hero": map[string]interface{}{"url": "https://cdn.example.com/a.png"},
+	}}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+
+	for _, args := range [][2]string{{"", "https://cdn.example.com/a.png"}, {"hero", ""}} {
+		if _, err := m.SetItemImageFromURL("c1", "i1", args[0], args[1], false); err == nil {
+			t.Errorf("SetItemImageFromURL(%q, %q) succeeded", args[0], args[1])
+		}
+	}
+	if n := len(rec.requests()); n != 1 {
+		t.Errorf("%d requests were made, want 1", n)
+	}
+}
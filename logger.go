@@ -0,0 +1,85 @@
+package webflow
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Logger defines the methods needed to log the requests made by the client.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// defaultLogger is the logger used when Debug is enabled without a Logger.
+var defaultLogger Logger = log.New(os.Stderr, "webflow: ", log.LstdFlags)
+
+// logger returns the logger requests are logged to, or nil when logging is disabled.
+func (m *Webflow) logger() Logger {
+	if m.Logger != nil {
+		return m.Logger
+	}
+	if m.Debug {
+		return defaultLogger
+	}
+	return nil
+}
+
+// logRequest logs the outgoing request with its body, redacting the credentials.
+func logRequest(l Logger, req *http.Request, body []byte, ct string) {
+	l.Printf("%s %s %s", req.Method, req.URL, formatHeader(req.Header))
+	if strings.HasPrefix(ct, "application/json") {
+		l.Printf("request body: %s", redactBody(body))
+	} else {
+		l.Printf("request body: %d bytes of %s", len(body), ct)
+	}
+}
+
+// redactedKeys are the keys of JSON request bodies whose values are credentials, such
+// as the ones sent to the OAuth endpoints.
+var redactedKeys = []string{"client_id", "client_secret", "code", "access_token"}
+
+// redactBody returns the JSON body with the values of the top-level redactedKeys
+// redacted. Bodies holding none of them are returned as they are.
+func redactBody(body []byte) []byte {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+	redacted := false
+	for _, k := range redactedKeys {
+		if _, ok := v[k]; ok {
+			v[k] = json.RawMessage(`"[REDACTED]"`)
+			redacted = true
+		}
+	}
+	if !redacted {
+		return body
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+// formatHeader returns the header as a string with the Authorization value redacted.
+func formatHeader(h http.Header) string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := strings.Join(h[k], ", ")
+		if k == "Authorization" {
+			v = "[REDACTED]"
+		}
+		parts = append(parts, k+": "+v)
+	}
+	return "[" + strings.Join(parts, "; ") + "]"
+}
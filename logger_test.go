@@ -0,0 +1,141 @@
+package webflow
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// bufferLogger is a Logger writing every line into a buffer.
+type bufferLogger struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Printf writes the formatted line into the buffer.
+func (l *bufferLogger) Printf(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(&l.buf, format+"\n", args...)
+}
+
+// String returns everything logged so far.
+func (l *bufferLogger) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.buf.String()
+}
+
+func TestLogger(t *testing.T) {
+	var rec recorder
+	var l bufferLogger
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "59")
+		rec.reply(http.StatusOK, `{"_id": "w1"}`)(w, r)
+	}, WithLogger(&l))
+
+	if _, err := m.CreateWebhook("s1", TriggerSitePublish, "https://example.com/publish", nil); err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	out := l.String()
+	for _, want := range []string{
+		"POST " + m.Host + "/sites/s1/webhooks",
+		`"triggerType":"site_publish"`,
+		"200 OK",
+		"59 requests remaining",
+		"Authorization: [REDACTED]",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log doesn't contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, testToken) {
+		t.Errorf("log contains the access token:\n%s", out)
+	}
+}
+
+func TestLoggerRateLimitRemaining(t *testing.T) {
+	tests := []struct {
+		name   string
+		header bool
+		track  bool
+		want   bool
+	}{
+		{"headers", true, true, true},
+		{"no headers", false, true, false},
+		{"tracking disabled", true, false, false},
+	}
+	for _, tt := range tests {
+		var l bufferLogger
+		m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if tt.header {
+				w.Header().Set("X-RateLimit-Remaining", "59")
+			}
+			w.Write([]byte(`[]`))
+		}, WithLogger(&l), WithRateLimitTracking(tt.track))
+
+		if _, err := m.ListSites(); err != nil {
+			t.Fatalf("%s: ListSites: %v", tt.name, err)
+		}
+		out := l.String()
+		if !strings.Contains(out, "GET /sites: 200 OK") {
+			t.Errorf("%s: log doesn't contain the response status:\n%s", tt.name, out)
+		}
+		if got := strings.Contains(out, "requests remaining"); got != tt.want {
+			t.Errorf("%s: log mentions requests remaining = %v, want %v:\n%s", tt.name, got, tt.want, out)
+		}
+	}
+}
+
+func TestLoggerDisabled(t *testing.T) {
+	m, err := NewClient(testToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if l := m.logger(); l != nil {
+		t.Errorf("logger() = %v without a Logger or Debug, want nil", l)
+	}
+	m.Debug = true
+	if l := m.logger(); l != defaultLogger {
+		t.Errorf("logger() = %v with Debug, want the default logger", l)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		body, want string
+	}{
+		{`{"client_secret":"s","grant_type":"authorization_code"}`, `{"client_secret":"[REDACTED]","grant_type":"authorization_code"}`},
+		{`{"access_token":"t","client_id":"i"}`, `{"access_token":"[REDACTED]","client_id":"[REDACTED]"}`},
+		{`{"fields":{"code":"SUMMER","name":"Sale"}}`, `{"fields":{"code":"SUMMER","name":"Sale"}}`},
+		{`[{"code":"x"}]`, `[{"code":"x"}]`},
+		{`null`, `null`},
+	}
+	for _, tt := range tests {
+		if got := string(redactBody([]byte(tt.body))); got != tt.want {
+			t.Errorf("redactBody(%s) = %s, want %s", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestLoggerRateLimitExhausted(t *testing.T) {
+	var l bufferLogger
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"msg": "Rate limit hit", "code": 429}`))
+	}, WithLogger(&l))
+
+	if _, err := m.GetSite("s1"); err == nil {
+		t.Fatal("GetSite succeeded despite the rate limit")
+	}
+	want := "rate limit exhausted: method=GET path=/sites/s1 status=429 retry_after=30s"
+	if out := l.String(); !strings.Contains(out, want) {
+		t.Errorf("log doesn't contain %q:\n%s", want, out)
+	}
+}
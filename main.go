@@ -2,25 +2,97 @@ package webflow
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
 	"net"
 	"net/http"
+	"net/textproto"
+	"net/url"
 	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-// Param for http get parameter
+// Param for http get parameter. Page is one-based and PerPage is capped at 100.
 type Param struct {
 	Page    int
 	PerPage int
 }
 
+// Pagination defines the position of a page of results within all results of a list
+// endpoint.
+type Pagination struct {
+	Count  int `json:"count"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+	Total  int `json:"total"`
+}
+
+// listPage decodes the pagination metadata of a list response, which the v1 API
+// returns next to the results and the v2 API nests under a pagination field.
+type listPage struct {
+	Pagination
+	Nested *Pagination `json:"pagination"`
+}
+
+// pagination returns the pagination metadata of a page holding count results.
+func (l listPage) pagination(count int) Pagination {
+	p := l.Pagination
+	if l.Nested != nil {
+		p = *l.Nested
+	}
+	if p.Count == 0 {
+		p.Count = count
+	}
+	return p
+}
+
+// maxPerPage is the maximum number of results the API returns per page.
+const maxPerPage = 100
+
+// toQuery returns the offset and limit query parameters for the page. No parameters are
+// returned when PerPage isn't set, leaving the API to use its defaults.
+func (p Param) toQuery() url.Values {
+	q := url.Values{}
+	limit := p.PerPage
+	if limit > maxPerPage {
+		limit = maxPerPage
+	}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+		if p.Page > 1 {
+			q.Set("offset", strconv.Itoa((p.Page-1)*limit))
+		}
+	}
+	return q
+}
+
+// withQuery returns path with the merged query parameters appended. Later values
+// replace earlier ones for the same key.
+func withQuery(path string, qs ...url.Values) string {
+	q := url.Values{}
+	for _, values := range qs {
+		for k, v := range values {
+			q[k] = v
+		}
+	}
+	if len(q) == 0 {
+		return path
+	}
+	return path + "?" + q.Encode()
+}
+
 const (
 	// host is the default host of Webflow's API.
 	host = "https://api.webflow.com"
@@ -30,11 +102,54 @@ const (
 	defaultTimeout = 5 * time.Second
 	// defaultCode is the default error code for failures.
 	defaultCode = -1
+	// libraryVersion is the version of this package, sent in the default User-Agent.
+	libraryVersion = "0.1.0"
+	// defaultUserAgent is the default User-Agent header sent with API requests.
+	defaultUserAgent = "jumbletv-webflow-go/" + libraryVersion
 )
 
 var (
 	// ErrorMissingTokenOrVersion for missing config
 	ErrorMissingTokenOrVersion = errors.New("missing webflow token or version")
+	// ErrorMissingSiteID for a missing site ID
+	ErrorMissingSiteID = errors.New("missing webflow site id")
+	// ErrorMissingCollectionID for a missing collection ID
+	ErrorMissingCollectionID = errors.New("missing webflow collection id")
+	// ErrorMissingItemID for a missing item ID
+	ErrorMissingItemID = errors.New("missing webflow item id")
+	// ErrorMissingWebhookID for a missing webhook ID
+	ErrorMissingWebhookID = errors.New("missing webflow webhook id")
+	// ErrorMissingFilePath for a missing path of a file to upload
+	ErrorMissingFilePath = errors.New("missing webflow upload file path")
+	// ErrorMissingProductID for a missing product ID
+	ErrorMissingProductID = errors.New("missing webflow product id")
+	// ErrorMissingSKUID for a missing SKU ID
+	ErrorMissingSKUID = errors.New("missing webflow sku id")
+	// ErrorMissingOrderID for a missing order ID
+	ErrorMissingOrderID = errors.New("missing webflow order id")
+	// ErrorMissingFormID for a missing form ID
+	ErrorMissingFormID = errors.New("missing webflow form id")
+	// ErrorMissingSubmissionID for a missing form submission ID
+	ErrorMissingSubmissionID = errors.New("missing webflow form submission id")
+	// ErrorMissingAssetID for a missing asset ID
+	ErrorMissingAssetID = errors.New("missing webflow asset id")
+	// ErrorMissingUserID for a missing user ID
+	ErrorMissingUserID = errors.New("missing webflow user id")
+	// ErrorNotModified for a GET whose response hasn't changed since it was last fetched
+	ErrorNotModified = errors.New("webflow resource not modified")
+	// ErrorDryRun for a request that wasn't sent because the client is in dry-run mode
+	ErrorDryRun = errors.New("webflow dry run, request not sent")
+)
+
+// APIVersion selects the version of Webflow's API that requests are routed to.
+type APIVersion int
+
+const (
+	// APIVersion1 routes requests to the v1 API, selecting the version with the
+	// Accept-Version header.
+	APIVersion1 APIVersion = iota + 1
+	// APIVersion2 routes requests to the v2 API under the /v2 path prefix.
+	APIVersion2
 )
 
 // fileOpener defines the methods needed to support file uploads.
@@ -42,41 +157,133 @@ type fileOpener interface {
 	Open(name string) (io.ReadCloser, error)
 }
 
-// Error defines an error received when making a request to the API.
+// Error defines an error received when making a request to the API. Status holds the
+// HTTP status of the response, or 0 when the request failed before a response was
+// received, and Name holds the API's name for the error when it provides one. Problems
+// holds the individual failures of a validation error, such as the fields of an item
+// that were rejected. Use errors.As with an Error or a *Error to inspect it.
 type Error struct {
-	Message string `json:"message"`
-	Code    int    `json:"code"`
+	Message  string   `json:"message"`
+	Code     int      `json:"code"`
+	Name     string   `json:"name,omitempty"`
+	Problems []string `json:"problems,omitempty"`
+	Status   int      `json:"-"`
 }
 
-// Webflow defines the Webflow client.
+// Webflow defines the Webflow client. A client is safe for concurrent use by multiple
+// goroutines once configured; its exported fields must not be changed while requests
+// are in flight, and the rate-limit budget is read with RateLimitStatus.
 type Webflow struct {
 	AccessToken string
 	Host        string
+	BasePath    string
+	UserAgent   string
 	Version     string
+	APIVersion  APIVersion
 	Debug       bool
 	Timeout     time.Duration
 	Transport   http.RoundTripper
 	RateLimit   int
 	Remaining   int
-	fs          fileOpener
+	MaxRetries  int
+	// TrackRateLimit records the rate-limit headers of responses into RateLimit and
+	// Remaining. It's on by default; turning it off also turns off AutoThrottle, which
+	// relies on the recorded budget.
+	TrackRateLimit bool
+	AutoThrottle   bool
+	DryRun         bool
+	ETags          bool
+	Logger         Logger
+	// BeforeRequest is called with every request right before it's sent, and may add
+	// headers to it.
+	BeforeRequest func(*http.Request)
+	// AfterResponse is called with the response to every request, or with the error
+	// when no response was received. The body of the response must be left unread.
+	AfterResponse func(*http.Response, error)
+	fs            fileOpener
+	// mu guards the rate-limit state, the ETags and the HTTP client, which concurrent
+	// requests update.
+	mu     sync.Mutex
+	client *http.Client
+	// throttleUntil is when the rate-limit window resets after the budget ran out.
+	throttleUntil time.Time
+	// etags holds the last ETag returned for each URL fetched while ETags is set.
+	etags map[string]string
 }
 
 // Error returns a string representing the error, satisfying the error interface.
 func (e Error) Error() string {
+	if len(e.Problems) > 0 {
+		return fmt.Sprintf("Webflow: %s: %s (%d)", e.Message, strings.Join(e.Problems, "; "), e.Code)
+	}
 	return fmt.Sprintf("Webflow: %s (%d)", e.Message, e.Code)
 }
 
+// As lets errors.As find the error through a *Error target, setting it to a copy.
+func (e Error) As(target interface{}) bool {
+	if p, ok := target.(**Error); ok {
+		*p = &e
+		return true
+	}
+	return false
+}
+
+// RateLimitError defines the error received when a request was rejected for exceeding
+// the rate limit. RetryAfter holds the delay the API asked for before the next request,
+// or 0 when it gave none.
+type RateLimitError struct {
+	Err        Error
+	RetryAfter time.Duration
+}
+
+// Error returns a string representing the error, satisfying the error interface.
+func (e RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying Error.
+func (e RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// DryRunError defines the error returned by requests made in dry-run mode. Request is
+// the request that would have been sent, and the error matches ErrorDryRun with
+// errors.Is.
+type DryRunError struct {
+	Request *http.Request
+}
+
+// Error returns a string representing the error, satisfying the error interface.
+func (e DryRunError) Error() string {
+	return fmt.Sprintf("%s: %s %s", ErrorDryRun, e.Request.Method, e.Request.URL)
+}
+
+// Is reports whether target is ErrorDryRun.
+func (e DryRunError) Is(target error) bool {
+	return target == ErrorDryRun
+}
+
 // NewClient returns a new Webflow API client which can be used to make RPC requests.
-func NewClient(secret string) (*Webflow, error) {
+// The options are applied on top of the defaults.
+func NewClient(secret string, opts ...Option) (*Webflow, error) {
 	if secret == "" {
 		return nil, errors.New("missing webflow authentication token")
 	}
-	return &Webflow{
-		AccessToken: secret,
-		Host:        host,
-		Version:     defaultVersion,
-		Debug:       false,
-		Timeout:     defaultTimeout,
+	return newClient(secret, opts...), nil
+}
+
+// newClient returns a new Webflow API client with the options applied on top of the
+// defaults. The secret may be empty for requests that don't need authentication.
+func newClient(secret string, opts ...Option) *Webflow {
+	m := &Webflow{
+		AccessToken:    secret,
+		Host:           host,
+		UserAgent:      defaultUserAgent,
+		Version:        defaultVersion,
+		APIVersion:     APIVersion1,
+		Debug:          false,
+		Timeout:        defaultTimeout,
+		TrackRateLimit: true,
 		Transport: &http.Transport{
 			DialContext: (&net.Dialer{
 				Timeout:   60 * time.Second,
@@ -90,90 +297,455 @@ func NewClient(secret string) (*Webflow, error) {
 			DisableKeepAlives:   false,
 		},
 		fs: osFS{},
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 // generateJSONRequestData returns the body and content type for a JSON request.
 func (m *Webflow) generateJSONRequestData(cr clientRequest) ([]byte, string, error) {
 	body, err := json.Marshal(cr.data)
 	if err != nil {
-		return nil, "", Error{fmt.Sprintf("Could not marshal JSON: %s", err), defaultCode}
+		return nil, "", Error{Message: fmt.Sprintf("Could not marshal JSON: %s", err), Code: defaultCode}
 	}
 	return body, "application/json", nil
 }
 
+// generateMultipartRequestData returns the body and content type for a multipart request
+// uploading the file named by the request, along with the request's form fields.
+func (m *Webflow) generateMultipartRequestData(cr clientRequest) ([]byte, string, error) {
+	f, err := m.fs.Open(cr.file)
+	if err != nil {
+		return nil, "", Error{Message: fmt.Sprintf("Could not open file: %s", err), Code: defaultCode}
+	}
+	defer f.Close()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if fields, ok := cr.data.(map[string]string); ok {
+		for k, v := range fields {
+			if err := w.WriteField(k, v); err != nil {
+				return nil, "", Error{Message: fmt.Sprintf("Could not write form field: %s", err), Code: defaultCode}
+			}
+		}
+	}
+	name := filepath.Base(cr.file)
+	ct := mime.TypeByExtension(filepath.Ext(name))
+	if ct == "" {
+		ct = "application/octet-stream"
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(name)))
+	h.Set("Content-Type", ct)
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return nil, "", Error{Message: fmt.Sprintf("Could not create form file: %s", err), Code: defaultCode}
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return nil, "", Error{Message: fmt.Sprintf("Could not read file: %s", err), Code: defaultCode}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", Error{Message: fmt.Sprintf("Could not write multipart body: %s", err), Code: defaultCode}
+	}
+	return body.Bytes(), w.FormDataContentType(), nil
+}
+
+// Do makes a request to any endpoint of Webflow's API that the client doesn't cover
+// yet. The body is sent as JSON and the response is decoded into result, with the same
+// authentication, versioning and rate-limit tracking as every other request.
+func (m *Webflow) Do(method, path string, body interface{}, result interface{}) error {
+	return m.DoCtx(context.Background(), method, path, body, result)
+}
+
+// DoCtx is like Do but uses ctx for the request.
+func (m *Webflow) DoCtx(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method: method,
+		path:   path,
+		data:   body,
+	}, result)
+}
+
 // request makes a request to Webflow's API
 func (m *Webflow) request(cr clientRequest, result interface{}) error {
-	body, ct, err := m.generateJSONRequestData(cr)
+	return m.requestCtx(context.Background(), cr, result)
+}
+
+// requestCtx makes a request to Webflow's API, aborting it when ctx is done. Responses
+// with a 429 or 5xx status are retried up to MaxRetries times.
+func (m *Webflow) requestCtx(ctx context.Context, cr clientRequest, result interface{}) error {
+	cr = withContextSettings(ctx, cr)
+	generate := requestDataGenerator(m.generateJSONRequestData)
+	if cr.file != "" {
+		generate = m.generateMultipartRequestData
+	}
+	body, ct, err := generate(cr)
 	if err != nil {
 		return err
 	}
-	// Construct the request
-	req, err := http.NewRequest(cr.method, m.Host+cr.path, bytes.NewReader(body))
+	for attempt := 0; ; attempt++ {
+		if err := m.throttle(ctx); err != nil {
+			return err
+		}
+		res, err := m.do(ctx, cr, body, ct, result)
+		if err == nil || res == nil || !retryable(res.StatusCode) || attempt >= m.MaxRetries {
+			return err
+		}
+		if err := sleep(ctx, retryDelay(res.Header, attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// do makes a single attempt of a request to Webflow's API. The response is returned
+// alongside any error once a reply has been received, with its body already closed.
+func (m *Webflow) do(ctx context.Context, cr clientRequest, body []byte, ct string, result interface{}) (*http.Response, error) {
+	req, err := m.buildRequest(ctx, cr, body, ct)
 	if err != nil {
-		return Error{fmt.Sprintf("Could not create request: %s", err), defaultCode}
+		return nil, err
 	}
-	req.Header.Add("Content-Type", ct)
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Add("Accept-Version", m.Version)
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", m.AccessToken))
 
-	// Create the HTTP client
-	client := &http.Client{
-		Transport: m.Transport,
-		Timeout:   m.Timeout,
+	if m.BeforeRequest != nil {
+		m.BeforeRequest(req)
+	}
+	l := m.logger()
+	if m.DryRun {
+		if l == nil {
+			l = defaultLogger
+		}
+		l.Printf("dry run, not sending:")
+		logRequest(l, req, body, ct)
+		return nil, DryRunError{Request: req}
+	}
+	if l != nil {
+		logRequest(l, req, body, ct)
 	}
+
 	// Make the request
+	client := m.httpClient()
+	if cr.timeout > 0 {
+		c := *client
+		c.Timeout = cr.timeout
+		client = &c
+	}
 	res, err := client.Do(req)
+	if m.AfterResponse != nil {
+		m.AfterResponse(res, err)
+	}
 	if err != nil {
-		return Error{fmt.Sprintf("Failed to make request: %s", err), defaultCode}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, Error{Message: fmt.Sprintf("Failed to make request: %s", err), Code: defaultCode}
 	}
 	defer res.Body.Close()
 
-	m.RateLimit, err = strconv.Atoi(res.Header["x-ratelimit-limit"][0])
-	if err != nil {
-		return Error{fmt.Sprintf("Failed to parse x-ratelimit-limit: %s", err), defaultCode}
+	remaining, reported := 0, false
+	if m.TrackRateLimit {
+		if remaining, reported, err = m.trackRateLimit(res.Header); err != nil {
+			return res, err
+		}
 	}
-	m.Remaining, err = strconv.Atoi(res.Header["x-ratelimit-remaining"][0])
-	if err != nil {
-		return Error{fmt.Sprintf("Failed to parse x-ratelimit-remaining: %s", err), defaultCode}
+	if l != nil {
+		if reported {
+			l.Printf("%s %s: %s, %d requests remaining", req.Method, req.URL.Path, res.Status, remaining)
+		} else {
+			l.Printf("%s %s: %s", req.Method, req.URL.Path, res.Status)
+		}
+	}
+	if l != nil && (res.StatusCode == http.StatusTooManyRequests || res.Header.Get("X-RateLimit-Remaining") == "0") {
+		wait, _ := retryAfter(res.Header)
+		l.Printf("rate limit exhausted: method=%s path=%s status=%d retry_after=%s", req.Method, req.URL.Path, res.StatusCode, wait)
 	}
 
-	// Parse the response
-	c, err := ioutil.ReadAll(res.Body)
+	// Responses without a body are done with before looking at their encoding.
+	if res.StatusCode == http.StatusNotModified {
+		return res, ErrorNotModified
+	}
+	if res.StatusCode == http.StatusNoContent {
+		return res, nil
+	}
+
+	// Parse the response. Transports with compression disabled leave gzip-encoded
+	// bodies to be decompressed here.
+	var r io.Reader = res.Body
+	if strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(res.Body)
+		if err != nil {
+			return res, Error{Message: fmt.Sprintf("Could not decompress response: %s", err), Code: defaultCode, Status: res.StatusCode}
+		}
+		defer gz.Close()
+		r = gz
+	}
+	c, err := ioutil.ReadAll(r)
 	if err != nil {
-		return Error{fmt.Sprintf("Could not read response: %s", err), defaultCode}
+		return res, Error{Message: fmt.Sprintf("Could not read response: %s", err), Code: defaultCode, Status: res.StatusCode}
+	}
+	if http.StatusOK <= res.StatusCode && res.StatusCode < http.StatusMultipleChoices {
+		if len(bytes.TrimSpace(c)) > 0 {
+			if err := decodeResponse(c, result); err != nil {
+				return res, Error{Message: fmt.Sprintf("Could not parse response: %s", err), Code: defaultCode, Status: res.StatusCode}
+			}
+		}
+		m.storeETag(req, res.Header.Get("ETag"))
+		return res, nil
+	}
+	e := responseError(res.StatusCode, c)
+	if res.StatusCode == http.StatusTooManyRequests {
+		d, _ := retryAfter(res.Header)
+		return res, RateLimitError{Err: e, RetryAfter: d}
+	}
+	return res, e
+}
+
+// responseError returns the Error for a failed response with the given status and body.
+func responseError(status int, body []byte) Error {
+	var env struct {
+		Errors  []Error     `json:"errors"`
+		Message string      `json:"message"`
+		Msg     string      `json:"msg"`
+		Name    string      `json:"name"`
+		Code    interface{} `json:"code"`
+		// Validation errors list their failures as problems in v1 and as details in v2.
+		Problems []string `json:"problems"`
+		Details  []struct {
+			Param       string `json:"param"`
+			Description string `json:"description"`
+		} `json:"details"`
+		// OAuth endpoints describe errors with these fields instead.
+		OAuthError       interface{} `json:"error"`
+		OAuthDescription string      `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &env); err != nil {
+		// Error responses from proxies and gateways aren't shaped like API errors.
+		return Error{Message: statusMessage(status, body), Code: status, Status: status}
+	}
+	if len(env.Errors) > 0 {
+		e := env.Errors[0]
+		e.Status = status
+		return e
+	}
+	problems := env.Problems
+	for _, d := range env.Details {
+		problems = append(problems, fmt.Sprintf("%s: %s", d.Param, d.Description))
+	}
+	e := Error{Message: env.Message, Code: status, Name: env.Name, Problems: problems, Status: status}
+	if e.Message == "" {
+		e.Message = env.Msg
+	}
+	if e.Message == "" {
+		e.Message = env.OAuthDescription
+	}
+	if name, ok := env.OAuthError.(string); ok && e.Name == "" {
+		e.Name = name
+	}
+	switch code := env.Code.(type) {
+	case float64:
+		e.Code = int(code)
+	case string:
+		if e.Name == "" {
+			e.Name = code
+		}
 	}
+	if e.Message == "" {
+		e.Message = statusMessage(status, body)
+	}
+	return e
+}
 
-	var env envelope
-	if err := json.Unmarshal(c, &env); err != nil {
-		return Error{fmt.Sprintf("Could not parse response: %s", err), defaultCode}
+// emptyListErrorNames holds the names, or v2 codes, of the 404 some list endpoints
+// return for a site or collection that exists but has nothing to list.
+var emptyListErrorNames = map[string]bool{
+	"NoItemsFound":   true,
+	"no_items_found": true,
+}
+
+// isEmptyListError reports whether err is the 404 of a list endpoint that found nothing
+// to list, which list methods return as an empty list. The 404 for a site or collection
+// that doesn't exist goes by a different name and is still returned as an error.
+func isEmptyListError(err error) bool {
+	e, ok := err.(Error)
+	return ok && e.Status == http.StatusNotFound && emptyListErrorNames[e.Name]
+}
+
+// httpClient returns the HTTP client requests are made with. The client is built once
+// and only rebuilt when Timeout or Transport were changed since.
+func (m *Webflow) httpClient() *http.Client {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.client == nil || m.client.Timeout != m.Timeout || !sameTransport(m.client.Transport, m.Transport) {
+		m.client = &http.Client{
+			Transport: m.Transport,
+			Timeout:   m.Timeout,
+		}
 	}
+	return m.client
+}
 
-	if http.StatusOK <= res.StatusCode && res.StatusCode < http.StatusMultipleChoices {
-		if env.Data != nil {
-			c, _ = json.Marshal(env.Data)
+// sameTransport reports whether a and b are the same transport. Transports of types
+// that can't be compared, such as functions, are never considered the same.
+func sameTransport(a, b http.RoundTripper) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+	return a == b
+}
+
+// trackRateLimit records the rate-limit budget reported by the response headers and
+// returns the number of requests remaining, and whether the response reported it.
+// Rate-limit headers are missing from some responses, in which case the last seen
+// values are kept.
+func (m *Webflow) trackRateLimit(h http.Header) (int, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v := h.Get("X-RateLimit-Limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return m.Remaining, false, Error{Message: fmt.Sprintf("Failed to parse x-ratelimit-limit: %s", err), Code: defaultCode}
+		}
+		m.RateLimit = n
+	}
+	if v := h.Get("X-RateLimit-Remaining"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return m.Remaining, false, Error{Message: fmt.Sprintf("Failed to parse x-ratelimit-remaining: %s", err), Code: defaultCode}
+		}
+		m.Remaining = n
+		if m.AutoThrottle && n <= 0 {
+			wait, ok := retryAfter(h)
+			if !ok {
+				wait = rateLimitWindow
+			}
+			m.throttleUntil = time.Now().Add(wait)
+		}
+		return n, true, nil
+	}
+	return m.Remaining, false, nil
+}
+
+// RateLimitStatus returns the rate limit and the number of requests remaining as last
+// reported by the API. It's safe to call while requests are in flight, unlike reading
+// the RateLimit and Remaining fields directly.
+func (m *Webflow) RateLimitStatus() (limit int, remaining int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.RateLimit, m.Remaining
+}
+
+// buildRequest returns the HTTP request for the client request with the given body.
+func (m *Webflow) buildRequest(ctx context.Context, cr clientRequest, body []byte, ct string) (*http.Request, error) {
+	path := cr.path
+	v2 := m.apiVersion(cr) == APIVersion2
+	if v2 {
+		path = "/v2" + path
+	}
+	req, err := http.NewRequestWithContext(ctx, cr.method, m.endpoint(path), bytes.NewReader(body))
+	if err != nil {
+		return nil, Error{Message: fmt.Sprintf("Could not create request: %s", err), Code: defaultCode}
+	}
+	req.Header.Add("Content-Type", ct)
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Accept-Charset", "utf-8")
+	if m.UserAgent != "" {
+		req.Header.Set("User-Agent", m.UserAgent)
+	}
+	if !v2 {
+		version := m.Version
+		if cr.version != "" {
+			version = cr.version
 		}
-		return json.Unmarshal(c, &result)
+		req.Header.Add("Accept-Version", version)
+	}
+	if m.AccessToken != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", m.AccessToken))
+	}
+	if cr.idempotencyKey != "" {
+		req.Header.Add("Idempotency-Key", cr.idempotencyKey)
+	}
+	if tag := m.etag(req); tag != "" {
+		req.Header.Add("If-None-Match", tag)
+	}
+	return req, nil
+}
+
+// endpoint returns the URL of the API path on Host, below BasePath when one is set for
+// gateways serving the API under a path prefix.
+func (m *Webflow) endpoint(path string) string {
+	u := strings.TrimRight(m.Host, "/")
+	if b := strings.Trim(m.BasePath, "/"); b != "" {
+		u += "/" + b
 	}
-	e := env.Errors[0]
-	return Error{e.Message, e.Code}
+	return u + "/" + strings.TrimLeft(path, "/")
 }
 
-// payload defines a struct to represent payloads that are returned from Medium.
-type envelope struct {
-	Limit     int32
-	Remaining int32
-	Data      interface{} `json:"data"`
-	Errors    []Error     `json:"errors,omitempty"`
+// apiVersion returns the API version the request is routed to, which is the client's
+// unless the request targets an endpoint only available in a specific version.
+func (m *Webflow) apiVersion(cr clientRequest) APIVersion {
+	if cr.apiVersion != 0 {
+		return cr.apiVersion
+	}
+	if m.APIVersion != 0 {
+		return m.APIVersion
+	}
+	return APIVersion1
+}
+
+// maxErrorBody is the maximum number of bytes of a response body included in an error.
+const maxErrorBody = 512
+
+// statusMessage returns an error message built from the HTTP status and response body.
+func statusMessage(status int, body []byte) string {
+	msg := http.StatusText(status)
+	b := strings.TrimSpace(string(body))
+	if len(b) > maxErrorBody {
+		b = b[:maxErrorBody] + "..."
+	}
+	if b != "" {
+		msg += ": " + b
+	}
+	return msg
+}
+
+// decodeResponse decodes the body of a successful response into result. Some endpoints
+// wrap their payload in an envelope whose only field is data while others, list
+// endpoints in particular, return it at the top level of the body. Bodies with a data
+// field among others, such as members or items with a data field, aren't envelopes.
+func decodeResponse(body []byte, result interface{}) error {
+	b := bytes.TrimSpace(body)
+	if len(b) > 0 && b[0] == '{' {
+		var env map[string]json.RawMessage
+		if err := json.Unmarshal(b, &env); err != nil {
+			return err
+		}
+		if data, ok := env["data"]; ok && len(env) == 1 && string(data) != "null" {
+			b = data
+		}
+	}
+	return json.Unmarshal(b, &result)
 }
 
 // clientRequest defines information that can be used to make a request to Webflow.
+// When file is set the request is sent as a multipart upload of that file, and when
+// apiVersion, version or timeout are set they override the client's for this request
+// only. The idempotencyKey is sent with every attempt of the request so that the API
+// can tell retries apart from new requests.
 type clientRequest struct {
-	method string
-	path   string
-	data   interface{}
+	method         string
+	path           string
+	data           interface{}
+	file           string
+	apiVersion     APIVersion
+	version        string
+	timeout        time.Duration
+	idempotencyKey string
 }
 
 // osFS is an implementation of fileOpener that uses the disk.
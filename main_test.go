@@ -0,0 +1,795 @@
+package webflow
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testToken is the access token of clients returned by newTestClient.
+const testToken = "test-token"
+
+// newTestClient returns a client whose requests are sent through the Transport field to
+// a test server serving h. The server is closed when the test ends.
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...Option) *Webflow {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	m, err := NewClient(testToken, append([]Option{WithHost(srv.URL)}, opts...)...)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	m.Transport = srv.Client().Transport
+	return m
+}
+
+// recordedRequest holds the parts of a request received by a test server.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// recorder records the requests received by a test server.
+type recorder struct {
+	mu   sync.Mutex
+	reqs []recordedRequest
+}
+
+// record adds r to the recorded requests.
+func (rec *recorder) record(r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.reqs = append(rec.reqs, recordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   b,
+	})
+}
+
+// reply returns a handler that records every request and replies with status and body.
+func (rec *recorder) reply(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+}
+
+// requests returns the recorded requests.
+func (rec *recorder) requests() []recordedRequest {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]recordedRequest(nil), rec.reqs...)
+}
+
+// last returns the last recorded request, failing the test when there's none.
+func (rec *recorder) last(t *testing.T) recordedRequest {
+	t.Helper()
+	reqs := rec.requests()
+	if len(reqs) == 0 {
+		t.Fatal("no request was made")
+	}
+	return reqs[len(reqs)-1]
+}
+
+// jsonBody decodes the JSON body of the request, failing the test when it's invalid.
+func (r recordedRequest) jsonBody(t *testing.T) map[string]interface{} {
+	t.Helper()
+	var v map[string]interface{}
+	if err := json.Unmarshal(r.Body, &v); err != nil {
+		t.Fatalf("invalid request body %q: %v", r.Body, err)
+	}
+	return v
+}
+
+// assertRequest fails the test when the request wasn't made with the method and path.
+func assertRequest(t *testing.T, r recordedRequest, method, path string) {
+	t.Helper()
+	if r.Method != method || r.Path != path {
+		t.Errorf("request = %s %s, want %s %s", r.Method, r.Path, method, path)
+	}
+}
+
+func TestRequestWithoutRateLimitHeaders(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if len(rec.requests()) == 0 {
+			w.Header().Set("X-RateLimit-Limit", "60")
+			w.Header().Set("X-RateLimit-Remaining", "42")
+		}
+		rec.reply(http.StatusOK, `[]`)(w, r)
+	})
+
+	if _, err := m.ListSites(); err != nil {
+		t.Fatalf("ListSites: %v", err)
+	}
+	if limit, remaining := m.RateLimitStatus(); limit != 60 || remaining != 42 {
+		t.Fatalf("RateLimitStatus() = %d, %d, want 60, 42", limit, remaining)
+	}
+	if _, err := m.ListSites(); err != nil {
+		t.Fatalf("ListSites without rate-limit headers: %v", err)
+	}
+	if limit, remaining := m.RateLimitStatus(); limit != 60 || remaining != 42 {
+		t.Errorf("RateLimitStatus() = %d, %d after a response without headers, want 60, 42", limit, remaining)
+	}
+}
+
+func TestRateLimitTrackingDisabled(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if len(rec.requests()) == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "not a number")
+		}
+		rec.reply(http.StatusOK, `[]`)(w, r)
+	}, WithRateLimitTracking(false))
+
+	for i := 0; i < 2; i++ {
+		if _, err := m.ListSites(); err != nil {
+			t.Fatalf("ListSites with tracking disabled: %v", err)
+		}
+	}
+	if limit, remaining := m.RateLimitStatus(); limit != 0 || remaining != 0 {
+		t.Errorf("RateLimitStatus() = %d, %d with tracking disabled, want 0, 0", limit, remaining)
+	}
+}
+
+func TestRequestErrorWithHTMLBody(t *testing.T) {
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("<html><body>Internal Server Error</body></html>"))
+	})
+
+	_, err := m.ListSites()
+	var e Error
+	if !errors.As(err, &e) {
+		t.Fatalf("ListSites error = %#v, want an Error", err)
+	}
+	if e.Status != http.StatusInternalServerError || e.Code != http.StatusInternalServerError {
+		t.Errorf("error status, code = %d, %d, want 500", e.Status, e.Code)
+	}
+	if !strings.Contains(e.Message, "Internal Server Error</body>") {
+		t.Errorf("error message %q doesn't include the body", e.Message)
+	}
+}
+
+func TestRequestErrorWithoutErrorsArray(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		rec.reply(http.StatusTooManyRequests, `{"msg": "Rate limit hit", "code": 429, "name": "RateLimit"}`)(w, r)
+	})
+
+	_, err := m.ListSites()
+	var rl RateLimitError
+	if !errors.As(err, &rl) {
+		t.Fatalf("ListSites error = %#v, want a RateLimitError", err)
+	}
+	if rl.RetryAfter != 30*time.Second {
+		t.Errorf("RetryAfter = %s, want 30s", rl.RetryAfter)
+	}
+	if e := rl.Err; e.Message != "Rate limit hit" || e.Name != "RateLimit" || e.Status != http.StatusTooManyRequests {
+		t.Errorf("unexpected error %#v", e)
+	}
+}
+
+func TestRequestCancel(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	if _, err := m.ListSitesCtx(ctx); err != context.Canceled {
+		t.Errorf("ListSitesCtx error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRequestErrorFields(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   Error
+	}{
+		{http.StatusNotFound, `{"msg": "Requested resource not found", "code": 404, "name": "NotFound"}`,
+			Error{Message: "Requested resource not found", Code: 404, Name: "NotFound", Status: 404}},
+		{http.StatusBadRequest, `{"message": "Validation Error", "code": "validation_error"}`,
+			Error{Message: "Validation Error", Code: 400, Name: "validation_error", Status: 400}},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(tt.status, tt.body))
+
+		err := m.Do(http.MethodGet, "/sites", nil, nil)
+		var e Error
+		if !errors.As(err, &e) {
+			t.Fatalf("Do error = %#v, want an Error", err)
+		}
+		if e.Message != tt.want.Message || e.Code != tt.want.Code || e.Name != tt.want.Name || e.Status != tt.want.Status {
+			t.Errorf("error = %#v, want %#v", e, tt.want)
+		}
+	}
+	if got, want := (Error{Message: "Requested resource not found", Code: 404}).Error(), "Webflow: Requested resource not found (404)"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIVersionRouting(t *testing.T) {
+	tests := []struct {
+		version     APIVersion
+		path        string
+		wantVersion string
+	}{
+		{APIVersion1, "/sites", defaultVersion},
+		{APIVersion2, "/v2/sites", ""},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `[]`), WithAPIVersion(tt.version))
+
+		if err := m.Do(http.MethodGet, "/sites", nil, nil); err != nil {
+			t.Fatalf("Do with API version %d: %v", tt.version, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodGet, tt.path)
+		if got := r.Header.Get("Accept-Version"); got != tt.wantVersion {
+			t.Errorf("API version %d: Accept-Version = %q, want %q", tt.version, got, tt.wantVersion)
+		}
+		if got, want := r.Header.Get("Authorization"), "Bearer "+testToken; got != want {
+			t.Errorf("API version %d: Authorization = %q, want %q", tt.version, got, want)
+		}
+	}
+}
+
+func TestDo(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"answer": 42}`))
+
+	var res struct {
+		Answer int `json:"answer"`
+	}
+	if err := m.Do(http.MethodPost, "made/up/path", map[string]string{"q": "life"}, &res); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if res.Answer != 42 {
+		t.Errorf("answer = %d, want 42", res.Answer)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/made/up/path")
+	if got := r.jsonBody(t)["q"]; got != "life" {
+		t.Errorf("body q = %v, want life", got)
+	}
+	if r.Header.Get("Authorization") != "Bearer "+testToken || r.Header.Get("Accept-Version") != defaultVersion {
+		t.Errorf("request isn't authenticated and versioned: %v", r.Header)
+	}
+}
+
+// withoutCompression makes the client's transport leave gzip-encoded responses as
+// they're received.
+func withoutCompression(m *Webflow) {
+	tr := m.Transport.(*http.Transport).Clone()
+	tr.DisableCompression = true
+	m.Transport = tr
+}
+
+func TestGzipResponse(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write([]byte(`[{"_id": "s1", "name": "First"}]`))
+		gz.Close()
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(buf.Bytes())
+	})
+	withoutCompression(m)
+
+	sites, err := m.ListSites()
+	if err != nil {
+		t.Fatalf("ListSites: %v", err)
+	}
+	if len(sites) != 1 || sites[0].Name != "First" {
+		t.Errorf("unexpected sites %+v", sites)
+	}
+}
+
+func TestGzipResponseWithoutBody(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNoContent, nil},
+		{http.StatusNotModified, ErrorNotModified},
+	}
+	for _, tt := range tests {
+		m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(tt.status)
+		})
+		withoutCompression(m)
+
+		if err := m.Do(http.MethodGet, "/sites", nil, nil); err != tt.want {
+			t.Errorf("Do with an empty gzip-encoded %d error = %v, want %v", tt.status, err, tt.want)
+		}
+	}
+}
+
+func TestParamToQuery(t *testing.T) {
+	tests := []struct {
+		p    Param
+		want string
+	}{
+		{Param{}, ""},
+		{Param{Page: 3}, ""},
+		{Param{PerPage: 10}, "limit=10"},
+		{Param{Page: 1, PerPage: 10}, "limit=10"},
+		{Param{Page: 3, PerPage: 10}, "limit=10&offset=20"},
+		{Param{Page: 2, PerPage: 500}, "limit=100&offset=100"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.toQuery().Encode(); got != tt.want {
+			t.Errorf("%+v.toQuery() = %q, want %q", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestWithQuery(t *testing.T) {
+	tests := []struct {
+		qs   []url.Values
+		want string
+	}{
+		{nil, "/items"},
+		{[]url.Values{{}}, "/items"},
+		{[]url.Values{{"limit": {"10"}}, {"status": {"pending"}}}, "/items?limit=10&status=pending"},
+		{[]url.Values{{"limit": {"10"}}, {"limit": {"20"}}}, "/items?limit=20"},
+	}
+	for _, tt := range tests {
+		if got := withQuery("/items", tt.qs...); got != tt.want {
+			t.Errorf("withQuery(%v) = %q, want %q", tt.qs, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeResponse(t *testing.T) {
+	var site Site
+	if err := decodeResponse([]byte(`{"data": {"_id": "s1", "name": "First"}}`), &site); err != nil {
+		t.Fatalf("decodeResponse of an envelope: %v", err)
+	}
+	if site.ID != "s1" || site.Name != "First" {
+		t.Errorf("unexpected site %+v", site)
+	}
+
+	var list struct {
+		Items []Item `json:"items"`
+		Total int    `json:"total"`
+	}
+	if err := decodeResponse([]byte(`{"items": [{"_id": "i1"}, {"_id": "i2"}], "count": 2, "offset": 0, "total": 5}`), &list); err != nil {
+		t.Fatalf("decodeResponse of a list: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[1].ID != "i2" || list.Total != 5 {
+		t.Errorf("unexpected list %+v", list)
+	}
+
+	var item Item
+	if err := decodeResponse([]byte(`{"_id": "i1", "slug": "first", "data": {"kind": "a field named data"}}`), &item); err != nil {
+		t.Fatalf("decodeResponse of an object with a data field: %v", err)
+	}
+	if item.ID != "i1" || item.Slug != "first" || item.Fields["data"] == nil {
+		t.Errorf("an object with a data field among others was unwrapped: %+v", item)
+	}
+
+	var sites []Site
+	if err := decodeResponse([]byte(` [{"_id": "s1"}]`), &sites); err != nil {
+		t.Fatalf("decodeResponse of an array: %v", err)
+	}
+	if len(sites) != 1 || sites[0].ID != "s1" {
+		t.Errorf("unexpected sites %+v", sites)
+	}
+}
+
+func TestHTTPClientReuse(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[]`))
+
+	c := m.httpClient()
+	for i := 0; i < 3; i++ {
+		if _, err := m.ListSites(); err != nil {
+			t.Fatalf("ListSites: %v", err)
+		}
+	}
+	if m.httpClient() != c {
+		t.Error("the HTTP client was rebuilt between requests")
+	}
+	m.Timeout = time.Minute
+	if rebuilt := m.httpClient(); rebuilt == c || rebuilt.Timeout != time.Minute {
+		t.Error("the HTTP client wasn't rebuilt after Timeout changed")
+	}
+}
+
+func TestHooks(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "s1"}`))
+	var before []*http.Request
+	var after []*http.Response
+	m.BeforeRequest = func(r *http.Request) {
+		before = append(before, r)
+		r.Header.Set("X-Trace-Id", "t1")
+	}
+	m.AfterResponse = func(res *http.Response, err error) {
+		if err != nil {
+			t.Errorf("AfterResponse error = %v, want nil", err)
+		}
+		after = append(after, res)
+	}
+
+	if _, err := m.GetSite("s1"); err != nil {
+		t.Fatalf("GetSite: %v", err)
+	}
+	if len(before) != 1 || before[0].Method != http.MethodGet || before[0].URL.Path != "/sites/s1" {
+		t.Fatalf("BeforeRequest was called with %v, want the GET of the site", before)
+	}
+	if got := rec.last(t).Header.Get("X-Trace-Id"); got != "t1" {
+		t.Errorf("header added by BeforeRequest = %q, want t1", got)
+	}
+	if len(after) != 1 || after[0].StatusCode != http.StatusOK || after[0].Request.URL.Path != "/sites/s1" {
+		t.Errorf("AfterResponse was called with %v, want the response to the request", after)
+	}
+}
+
+func TestAfterResponseError(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	m, _ := NewClient(testToken, WithHost(srv.URL))
+	var calls int
+	m.AfterResponse = func(res *http.Response, err error) {
+		calls++
+		if res != nil || err == nil {
+			t.Errorf("AfterResponse called with (%v, %v), want no response and an error", res, err)
+		}
+	}
+
+	if _, err := m.GetSite("s1"); err == nil {
+		t.Error("GetSite succeeded against a closed server")
+	}
+	if calls != 1 {
+		t.Errorf("AfterResponse was called %d times, want 1", calls)
+	}
+}
+
+func BenchmarkRequest(b *testing.B) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"_id": "s1", "name": "First"}]`))
+	}))
+	defer srv.Close()
+	m, _ := NewClient(testToken, WithHost(srv.URL))
+	m.Transport = srv.Client().Transport
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.ListSites(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchmarkItems returns the body of a page of n items.
+func benchmarkItems(n int) []byte {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"_id": "i%d", "_cid": "c1", "slug": "item-%d", "name": "Item %d", "body": "<p>Lorem ipsum dolor sit amet.</p>", "_draft": false, "_archived": false}`, i, i, i)
+	}
+	return []byte(fmt.Sprintf(`{"items": [%s], "count": %d, "limit": %d, "offset": 0, "total": %d}`, strings.Join(items, ","), n, n, n))
+}
+
+func BenchmarkDecodeResponse(b *testing.B) {
+	body := benchmarkItems(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var res struct {
+			Items []Item `json:"items"`
+		}
+		c, err := ioutil.ReadAll(bytes.NewReader(body))
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := decodeResponse(c, &res); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestBasePath(t *testing.T) {
+	tests := []struct {
+		host, basePath string
+	}{
+		{"", "/webflow"},
+		{"/", "webflow/"},
+		{"", "/webflow/"},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `[]`), WithBasePath(tt.basePath))
+		m.Host += tt.host
+
+		if _, err := m.ListSites(); err != nil {
+			t.Fatalf("ListSites: %v", err)
+		}
+		assertRequest(t, rec.last(t), http.MethodGet, "/webflow/sites")
+	}
+	m := &Webflow{Host: "https://gw.internal/", BasePath: "/webflow/"}
+	if got, want := m.endpoint("/v2/sites"), "https://gw.internal/webflow/v2/sites"; got != want {
+		t.Errorf("endpoint = %q, want %q", got, want)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	var rec recorder
+	var l bufferLogger
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"deleted": 1}`), WithDryRun(), WithLogger(&l))
+
+	err := m.DeleteItems("c1", []string{"i1", "i2"})
+	if !errors.Is(err, ErrorDryRun) {
+		t.Fatalf("DeleteItems error = %v, want %v", err, ErrorDryRun)
+	}
+	var dr DryRunError
+	if !errors.As(err, &dr) {
+		t.Fatalf("DeleteItems error = %#v, want a DryRunError", err)
+	}
+	req := dr.Request
+	if req.Method != http.MethodDelete || req.URL.String() != m.Host+"/collections/c1/items" {
+		t.Errorf("request = %s %s, want DELETE %s/collections/c1/items", req.Method, req.URL, m.Host)
+	}
+	if req.Header.Get("Authorization") != "Bearer "+testToken || req.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected request header %v", req.Header)
+	}
+	if b, _ := ioutil.ReadAll(req.Body); string(b) != `{"itemIds":["i1","i2"]}` {
+		t.Errorf("request body = %s", b)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were sent, want none", n)
+	}
+	if !strings.Contains(l.String(), "dry run") {
+		t.Errorf("the request wasn't logged:\n%s", l.String())
+	}
+}
+
+func TestDryRunDoesNotFabricateResults(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[]`), WithDryRun(), WithLogger(&bufferLogger{}))
+
+	if _, err := m.GetItem("c1", "i1"); !errors.Is(err, ErrorDryRun) {
+		t.Errorf("GetItem error = %v, want %v", err, ErrorDryRun)
+	}
+	if _, err := m.EnsureWebhook("s1", TriggerSitePublish, "https://example.com/publish", nil); !errors.Is(err, ErrorDryRun) {
+		t.Errorf("EnsureWebhook error = %v, want %v", err, ErrorDryRun)
+	}
+	if err := m.WaitForPublish(context.Background(), "s1", time.Millisecond); !errors.Is(err, ErrorDryRun) {
+		t.Errorf("WaitForPublish error = %v, want %v", err, ErrorDryRun)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were sent, want none", n)
+	}
+}
+
+func TestValidationErrorProblems(t *testing.T) {
+	tests := []struct {
+		body string
+		want []string
+	}{
+		{`{"msg": "Validation Failure", "code": 400, "name": "ValidationError", "problems": ["Field 'name': Field is required", "Field 'slug': Slug already in use"]}`,
+			[]string{"Field 'name': Field is required", "Field 'slug': Slug already in use"}},
+		{`{"code": "validation_error", "message": "Validation Error", "details": [{"param": "name", "description": "is required"}, {"param": "price", "description": "must be a number"}]}`,
+			[]string{"name: is required", "price: must be a number"}},
+		{`{"errors": [{"message": "Validation Failure", "code": 400, "problems": ["Field 'name': Field is required"]}]}`,
+			[]string{"Field 'name': Field is required"}},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusBadRequest, tt.body))
+
+		_, err := m.CreateItem("c1", map[string]interface{}{"name": "", "slug": "taken"}, false)
+		var e Error
+		if !errors.As(err, &e) {
+			t.Fatalf("CreateItem error = %#v, want an Error", err)
+		}
+		if got := e.Problems; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("problems = %q, want %q", got, tt.want)
+		}
+		if !strings.Contains(e.Error(), strings.Join(tt.want, "; ")) {
+			t.Errorf("Error() = %q doesn't list the problems", e.Error())
+		}
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	var err error = RateLimitError{Err: Error{Message: "Rate limit hit", Code: 429, Status: 429, Problems: []string{"a\nb", "c"}}}
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("errors.As(%#v) with a *Error target failed", err)
+	}
+	if e.Status != 429 || !reflect.DeepEqual(e.Problems, []string{"a\nb", "c"}) {
+		t.Errorf("unexpected error %#v", e)
+	}
+	b, err := json.Marshal(e)
+	if err != nil || !strings.Contains(string(b), `"problems":["a\nb","c"]`) {
+		t.Errorf("json.Marshal = %s, %v, want the problems as a list", b, err)
+	}
+}
+
+func TestRateLimitStatusConcurrent(t *testing.T) {
+	var remaining int32 = 1000
+	var mu sync.Mutex
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		remaining--
+		n := remaining
+		mu.Unlock()
+		w.Header().Set("X-RateLimit-Limit", "1000")
+		w.Header().Set("X-RateLimit-Remaining", fmt.Sprint(n))
+		w.Write([]byte(`[]`))
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if _, err := m.ListSites(); err != nil {
+				t.Errorf("ListSites: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			m.RateLimitStatus()
+		}()
+	}
+	wg.Wait()
+	if limit, rem := m.RateLimitStatus(); limit != 1000 || rem < 980 || rem >= 1000 {
+		t.Errorf("RateLimitStatus() = %d, %d, want 1000 and one of the last remaining counts", limit, rem)
+	}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "30")
+		w.Header().Set("ETag", `"`+r.URL.Path+`"`)
+		switch {
+		case r.URL.Path == "/sites":
+			rec.reply(http.StatusOK, `[{"_id": "s1"}]`)(w, r)
+		case r.Method == http.MethodPost:
+			rec.reply(http.StatusOK, `{"_id": "w1"}`)(w, r)
+		default:
+			rec.reply(http.StatusOK, `{"_id": "s1"}`)(w, r)
+		}
+	}, WithETags(), WithAutoThrottle())
+
+	const workers = 50
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			switch i % 3 {
+			case 0:
+				_, err = m.ListSites()
+			case 1:
+				_, err = m.GetSite(fmt.Sprintf("s%d", i%5))
+			case 2:
+				_, err = m.CreateWebhook("s1", TriggerSitePublish, "https://example.com/hook", nil)
+			}
+			if err != nil {
+				t.Errorf("request %d: %v", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if n := len(rec.requests()); n != workers {
+		t.Errorf("%d requests were made, want %d", n, workers)
+	}
+}
+
+func TestEmptyListNotFound(t *testing.T) {
+	lists := map[string]func(m *Webflow) (int, error){
+		"ListItems": func(m *Webflow) (int, error) {
+			items, _, err := m.ListItems("c1", Param{})
+			return len(items), err
+		},
+		"ListProducts": func(m *Webflow) (int, error) {
+			products, _, err := m.ListProducts("s1", Param{})
+			return len(products), err
+		},
+		"ListOrders": func(m *Webflow) (int, error) {
+			orders, err := m.ListOrders("s1", "", Param{})
+			return len(orders), err
+		},
+		"ListCollections": func(m *Webflow) (int, error) {
+			collections, err := m.ListCollections("s1")
+			return len(collections), err
+		},
+		"ListWebhooks": func(m *Webflow) (int, error) {
+			webhooks, err := m.ListWebhooks("s1")
+			return len(webhooks), err
+		},
+		"ListDomains": func(m *Webflow) (int, error) {
+			domains, err := m.ListDomains("s1")
+			return len(domains), err
+		},
+		"ListAssets": func(m *Webflow) (int, error) {
+			assets, err := m.ListAssets("s1", Param{})
+			return len(assets), err
+		},
+		"ListAssetFolders": func(m *Webflow) (int, error) {
+			folders, err := m.ListAssetFolders("s1")
+			return len(folders), err
+		},
+		"ListForms": func(m *Webflow) (int, error) {
+			forms, err := m.ListForms("s1", Param{})
+			return len(forms), err
+		},
+		"ListFormSubmissions": func(m *Webflow) (int, error) {
+			submissions, _, err := m.ListFormSubmissions("f1", Param{})
+			return len(submissions), err
+		},
+		"ListUsers": func(m *Webflow) (int, error) {
+			users, err := m.ListUsers("s1", Param{})
+			return len(users), err
+		},
+		"ListAccessGroups": func(m *Webflow) (int, error) {
+			groups, err := m.ListAccessGroups("s1", Param{})
+			return len(groups), err
+		},
+	}
+	empty := []string{
+		`{"msg": "No items found", "code": 404, "name": "NoItemsFound", "path": "/collections/c1/items"}`,
+		`{"code": "no_items_found", "message": "No items found"}`,
+	}
+	for name, list := range lists {
+		for _, body := range empty {
+			m := newTestClient(t, (&recorder{}).reply(http.StatusNotFound, body))
+			if n, err := list(m); err != nil || n != 0 {
+				t.Errorf("%s with the empty list 404 %s = %d, %v, want an empty list", name, body, n, err)
+			}
+		}
+
+		m := newTestClient(t, (&recorder{}).reply(http.StatusNotFound, `{"msg": "No items found for this site", "code": 404, "name": "NotFound"}`))
+		_, err := list(m)
+		var e Error
+		if !errors.As(err, &e) || e.Status != http.StatusNotFound {
+			t.Errorf("%s of a missing site or collection error = %#v, want a 404 Error", name, err)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package webflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"time"
+)
+
+// Statuses of a member.
+const (
+	MemberInvited    = "invited"
+	MemberVerified   = "verified"
+	MemberUnverified = "unverified"
+)
+
+// Member defines a user of a site using Webflow Memberships.
+type Member struct {
+	ID     string `json:"id"`
+	Email  string `json:"email"`
+	Status string `json:"status"`
+	// AccessGroups holds the slugs of the access groups the member belongs to.
+	AccessGroups []string  `json:"accessGroups"`
+	CreatedOn    time.Time `json:"createdOn"`
+	LastLogin    time.Time `json:"lastLogin"`
+}
+
+// UnmarshalJSON decodes a member, whose email the API returns among its data and whose
+// access groups it returns as objects.
+func (u *Member) UnmarshalJSON(b []byte) error {
+	type member Member
+	var res struct {
+		member
+		AccessGroups []struct {
+			Slug string `json:"slug"`
+		} `json:"accessGroups"`
+		Data struct {
+			Email string `json:"email"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	if res.Email == "" {
+		res.Email = res.Data.Email
+	}
+	res.member.AccessGroups = nil
+	for _, g := range res.AccessGroups {
+		res.member.AccessGroups = append(res.member.AccessGroups, g.Slug)
+	}
+	*u = Member(res.member)
+	return nil
+}
+
+// ListUsers returns a page of the members of the site.
+func (m *Webflow) ListUsers(siteID string, p Param) ([]Member, error) {
+	return m.ListUsersCtx(context.Background(), siteID, p)
+}
+
+// ListUsersCtx is like ListUsers but uses ctx for the request.
+func (m *Webflow) ListUsersCtx(ctx context.Context, siteID string, p Param) ([]Member, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	path := withQuery(fmt.Sprintf("/sites/%s/users", siteID), p.toQuery())
+	var res struct {
+		Users []Member `json:"users"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       path,
+		apiVersion: APIVersion2,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []Member{}, nil
+		}
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+// GetUser returns the member of the site with the given ID. A member that doesn't exist
+// is returned as an Error with a 404 code.
+func (m *Webflow) GetUser(siteID, userID string) (*Member, error) {
+	return m.GetUserCtx(context.Background(), siteID, userID)
+}
+
+// GetUserCtx is like GetUser but uses ctx for the request.
+func (m *Webflow) GetUserCtx(ctx context.Context, siteID, userID string) (*Member, error) {
+	return m.user(ctx, http.MethodGet, siteID, userID, nil)
+}
+
+// UpdateUser changes the given fields of the member, such as its data or its access
+// groups:
+//
+//	map[string]interface{}{"accessGroups": []string{"gold"}}
+func (m *Webflow) UpdateUser(siteID, userID string, fields map[string]interface{}) (*Member, error) {
+	return m.UpdateUserCtx(context.Background(), siteID, userID, fields)
+}
+
+// UpdateUserCtx is like UpdateUser but uses ctx for the request.
+func (m *Webflow) UpdateUserCtx(ctx context.Context, siteID, userID string, fields map[string]interface{}) (*Member, error) {
+	return m.user(ctx, http.MethodPatch, siteID, userID, fields)
+}
+
+// DeleteUser deletes the member of the site with the given ID.
+func (m *Webflow) DeleteUser(siteID, userID string) error {
+	return m.DeleteUserCtx(context.Background(), siteID, userID)
+}
+
+// DeleteUserCtx is like DeleteUser but uses ctx for the request.
+func (m *Webflow) DeleteUserCtx(ctx context.Context, siteID, userID string) error {
+	_, err := m.user(ctx, http.MethodDelete, siteID, userID, nil)
+	return err
+}
+
+// user makes a request to the member of the site.
+func (m *Webflow) user(ctx context.Context, method, siteID, userID string, data interface{}) (*Member, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if userID == "" {
+		return nil, ErrorMissingUserID
+	}
+	var member Member
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     method,
+		path:       fmt.Sprintf("/sites/%s/users/%s", siteID, userID),
+		data:       data,
+		apiVersion: APIVersion2,
+	}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// InviteUser invites the given email address to become a member of the site, adding
+// it to the access groups with the given slugs when there are any.
+func (m *Webflow) InviteUser(siteID, email string, accessGroups []string) (*Member, error) {
+	return m.InviteUserCtx(context.Background(), siteID, email, accessGroups)
+}
+
+// InviteUserCtx is like InviteUser but uses ctx for the request.
+func (m *Webflow) InviteUserCtx(ctx context.Context, siteID, email string, accessGroups []string) (*Member, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, fmt.Errorf("invalid webflow user email %q", email)
+	}
+	data := map[string]interface{}{
+		"email": email,
+	}
+	if len(accessGroups) > 0 {
+		data["accessGroups"] = accessGroups
+	}
+	var member Member
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodPost,
+		path:       fmt.Sprintf("/sites/%s/users/invite", siteID),
+		data:       data,
+		apiVersion: APIVersion2,
+	}, &member); err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// AccessGroup defines a group of members that gated content of a site is restricted to.
+type AccessGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	Type string `json:"type"`
+}
+
+// ListAccessGroups returns a page of the access groups of the site. The group slugs are
+// the values accepted by InviteUser and UpdateUser.
+func (m *Webflow) ListAccessGroups(siteID string, p Param) ([]AccessGroup, error) {
+	return m.ListAccessGroupsCtx(context.Background(), siteID, p)
+}
+
+// ListAccessGroupsCtx is like ListAccessGroups but uses ctx for the request.
+func (m *Webflow) ListAccessGroupsCtx(ctx context.Context, siteID string, p Param) ([]AccessGroup, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	path := withQuery(fmt.Sprintf("/sites/%s/accessgroups", siteID), p.toQuery())
+	var res struct {
+		AccessGroups []AccessGroup `json:"accessGroups"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method:     http.MethodGet,
+		path:       path,
+		apiVersion: APIVersion2,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []AccessGroup{}, nil
+		}
+		return nil, err
+	}
+	return res.AccessGroups, nil
+}
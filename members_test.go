@@ -0,0 +1,193 @@
+package webflow
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListUsers(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"users": [
+		{"id": "u1", "status": "verified", "accessGroups": [{"slug": "gold", "type": "admin"}], "data": {"email": "one@example.com"}},
+		{"id": "u2", "status": "invited", "data": {"email": "two@example.com"}},
+		{"id": "u3", "status": "unverified", "createdOn": "2022-01-02T03:04:05Z", "data": {"email": "three@example.com"}}
+	], "count": 3, "offset": 0, "total": 3}`))
+
+	users, err := m.ListUsers("s1", Param{PerPage: 3})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodGet, "/v2/sites/s1/users")
+	if got := r.Query.Get("limit"); got != "3" {
+		t.Errorf("limit = %q, want 3", got)
+	}
+	if len(users) != 3 {
+		t.Fatalf("got %d users, want 3", len(users))
+	}
+	for i, want := range []string{MemberVerified, MemberInvited, MemberUnverified} {
+		if users[i].Status != want {
+			t.Errorf("user %d status = %q, want %q", i, users[i].Status, want)
+		}
+	}
+	if u := users[0]; u.Email != "one@example.com" || !reflect.DeepEqual(u.AccessGroups, []string{"gold"}) {
+		t.Errorf("unexpected user %+v", u)
+	}
+	if users[2].CreatedOn.IsZero() {
+		t.Error("CreatedOn of the third user wasn't decoded")
+	}
+}
+
+// userBody is a member as the v2 API returns it, with its email among its data.
+const userBody = `{
+	"id": "u1",
+	"status": "verified",
+	"createdOn": "2022-01-02T03:04:05Z",
+	"accessGroups": [{"slug": "gold", "type": "admin"}, {"slug": "silver", "type": "ecommerce"}],
+	"data": {"email": "one@example.com", "name": "One"}
+}`
+
+func TestGetUser(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, userBody))
+
+	u, err := m.GetUser("s1", "u1")
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/v2/sites/s1/users/u1")
+	if u.ID != "u1" || u.Status != MemberVerified || u.Email != "one@example.com" {
+		t.Errorf("unexpected user %+v", u)
+	}
+	if want := []string{"gold", "silver"}; !reflect.DeepEqual(u.AccessGroups, want) {
+		t.Errorf("AccessGroups = %v, want %v", u.AccessGroups, want)
+	}
+	if u.CreatedOn.IsZero() {
+		t.Error("CreatedOn wasn't decoded")
+	}
+}
+
+func TestGetUserNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, `{"code": 404, "name": "NotFound", "message": "Requested resource not found"}`))
+
+	_, err := m.GetUser("s1", "u9")
+	var e Error
+	if !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("GetUser of a missing user error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestGetUserMissingIDs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, userBody))
+
+	if _, err := m.GetUser("", "u1"); err != ErrorMissingSiteID {
+		t.Errorf("GetUser without a site error = %v, want %v", err, ErrorMissingSiteID)
+	}
+	if _, err := m.UpdateUser("s1", "", nil); err != ErrorMissingUserID {
+		t.Errorf("UpdateUser without a user error = %v, want %v", err, ErrorMissingUserID)
+	}
+	if err := m.DeleteUser("s1", ""); err != ErrorMissingUserID {
+		t.Errorf("DeleteUser without a user error = %v, want %v", err, ErrorMissingUserID)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, userBody))
+
+	u, err := m.UpdateUser("s1", "u1", map[string]interface{}{"accessGroups": []string{"gold", "silver"}})
+	if err != nil {
+		t.Fatalf("UpdateUser: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPatch, "/v2/sites/s1/users/u1")
+	if got, want := r.jsonBody(t)["accessGroups"], []interface{}{"gold", "silver"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("accessGroups = %v, want %v", got, want)
+	}
+	if u.Email != "one@example.com" {
+		t.Errorf("Email = %q, want one@example.com", u.Email)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNoContent, ``))
+
+	if err := m.DeleteUser("s1", "u1"); err != nil {
+		t.Fatalf("DeleteUser: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodDelete, "/v2/sites/s1/users/u1")
+}
+
+func TestInviteUser(t *testing.T) {
+	tests := []struct {
+		accessGroups []string
+		want         map[string]interface{}
+	}{
+		{nil, map[string]interface{}{"email": "new@example.com"}},
+		{[]string{"gold"}, map[string]interface{}{"email": "new@example.com", "accessGroups": []interface{}{"gold"}}},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{"id": "u2", "status": "invited", "data": {"email": "new@example.com"}}`))
+
+		u, err := m.InviteUser("s1", "new@example.com", tt.accessGroups)
+		if err != nil {
+			t.Fatalf("InviteUser(%v): %v", tt.accessGroups, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPost, "/v2/sites/s1/users/invite")
+		if got := r.jsonBody(t); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("body = %v, want %v", got, tt.want)
+		}
+		if u.ID != "u2" || u.Status != MemberInvited || u.Email != "new@example.com" {
+			t.Errorf("unexpected user %+v", u)
+		}
+	}
+}
+
+func TestInviteUserInvalidEmail(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	for _, email := range []string{"", "not an email", "One <one@example.com>"} {
+		if _, err := m.InviteUser("s1", email, nil); err == nil {
+			t.Errorf("InviteUser(%q) succeeded", email)
+		}
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestListAccessGroups(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"accessGroups": [
+		{"id": "g1", "name": "Gold", "slug": "gold", "type": "admin"},
+		{"id": "g2", "name": "Silver", "slug": "silver", "type": "ecommerce"}
+	], "count": 2, "offset": 10, "total": 12}`))
+
+	groups, err := m.ListAccessGroups("s1", Param{Page: 2, PerPage: 10})
+	if err != nil {
+		t.Fatalf("ListAccessGroups: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodGet, "/v2/sites/s1/accessgroups")
+	if got := r.Query.Get("offset"); got != "10" {
+		t.Errorf("offset = %q, want 10", got)
+	}
+	want := []AccessGroup{
+		{ID: "g1", Name: "Gold", Slug: "gold", Type: "admin"},
+		{ID: "g2", Name: "Silver", Slug: "silver", Type: "ecommerce"},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("groups = %+v, want %+v", groups, want)
+	}
+}
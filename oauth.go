@@ -0,0 +1,84 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ExchangeCode exchanges the code an OAuth app received after being installed for an
+// access token, which can then be passed to NewClient. The options configure the
+// client the exchange is made with.
+func ExchangeCode(clientID, clientSecret, code, redirectURI string, opts ...Option) (string, error) {
+	return ExchangeCodeCtx(context.Background(), clientID, clientSecret, code, redirectURI, opts...)
+}
+
+// ExchangeCodeCtx is like ExchangeCode but uses ctx for the request.
+func ExchangeCodeCtx(ctx context.Context, clientID, clientSecret, code, redirectURI string, opts ...Option) (string, error) {
+	if clientID == "" || clientSecret == "" {
+		return "", errors.New("missing webflow oauth client id or secret")
+	}
+	if code == "" {
+		return "", errors.New("missing webflow oauth code")
+	}
+	data := map[string]interface{}{
+		"client_id":     clientID,
+		"client_secret": clientSecret,
+		"code":          code,
+		"grant_type":    "authorization_code",
+	}
+	if redirectURI != "" {
+		data["redirect_uri"] = redirectURI
+	}
+	var res struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+	}
+	if err := newClient("", opts...).requestCtx(ctx, clientRequest{
+		method:     http.MethodPost,
+		path:       "/oauth/access_token",
+		data:       data,
+		apiVersion: APIVersion1,
+	}, &res); err != nil {
+		return "", err
+	}
+	if res.AccessToken == "" {
+		return "", Error{Message: "Missing access token in response", Code: defaultCode}
+	}
+	return res.AccessToken, nil
+}
+
+// RevokeToken revokes the access token an OAuth app was given, uninstalling the app.
+// The options configure the client the revocation is made with.
+func RevokeToken(clientID, clientSecret, token string, opts ...Option) error {
+	return RevokeTokenCtx(context.Background(), clientID, clientSecret, token, opts...)
+}
+
+// RevokeTokenCtx is like RevokeToken but uses ctx for the request.
+func RevokeTokenCtx(ctx context.Context, clientID, clientSecret, token string, opts ...Option) error {
+	if clientID == "" || clientSecret == "" {
+		return errors.New("missing webflow oauth client id or secret")
+	}
+	if token == "" {
+		return errors.New("missing webflow oauth access token")
+	}
+	var res struct {
+		DidRevoke bool `json:"didRevoke"`
+	}
+	if err := newClient("", opts...).requestCtx(ctx, clientRequest{
+		method: http.MethodPost,
+		path:   "/oauth/revoke_authorization",
+		data: map[string]interface{}{
+			"client_id":     clientID,
+			"client_secret": clientSecret,
+			"access_token":  token,
+		},
+		apiVersion: APIVersion1,
+	}, &res); err != nil {
+		return err
+	}
+	if !res.DidRevoke {
+		return Error{Message: "Access token was not revoked", Code: defaultCode}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newOAuthServer returns a test server serving h, closed when the test ends.
+func newOAuthServer(t *testing.T, h http.HandlerFunc) *httptest.Server {
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestExchangeCode(t *testing.T) {
+	var rec recorder
+	srv := newOAuthServer(t, rec.reply(http.StatusOK, `{"access_token": "new-token", "token_type": "bearer"}`))
+
+	token, err := ExchangeCode("id", "secret", "code123", "https://example.com/callback", WithHost(srv.URL))
+	if err != nil {
+		t.Fatalf("ExchangeCode: %v", err)
+	}
+	if token != "new-token" {
+		t.Errorf("token = %q, want new-token", token)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/oauth/access_token")
+	body := r.jsonBody(t)
+	if body["client_id"] != "id" || body["client_secret"] != "secret" || body["code"] != "code123" ||
+		body["grant_type"] != "authorization_code" || body["redirect_uri"] != "https://example.com/callback" {
+		t.Errorf("unexpected body %v", body)
+	}
+	if h := r.Header.Get("Authorization"); h != "" {
+		t.Errorf("Authorization = %q, want none", h)
+	}
+}
+
+func TestExchangeCodeInvalidCode(t *testing.T) {
+	var rec recorder
+	srv := newOAuthServer(t, rec.reply(http.StatusBadRequest, `{"error": "invalid_grant", "error_description": "Invalid authorization code"}`))
+
+	_, err := ExchangeCode("id", "secret", "expired", "", WithHost(srv.URL))
+	var e Error
+	if !errors.As(err, &e) || e.Name != "invalid_grant" || e.Message != "Invalid authorization code" || e.Status != http.StatusBadRequest {
+		t.Errorf("ExchangeCode error = %#v, want an invalid_grant Error", err)
+	}
+}
+
+func TestExchangeCodeCtxCancel(t *testing.T) {
+	var rec recorder
+	srv := newOAuthServer(t, rec.reply(http.StatusOK, `{"access_token": "new-token"}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ExchangeCodeCtx(ctx, "id", "secret", "code123", "", WithHost(srv.URL)); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExchangeCodeCtx error = %v, want %v", err, context.Canceled)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestExchangeCodeLogRedacted(t *testing.T) {
+	var rec recorder
+	var l bufferLogger
+	srv := newOAuthServer(t, rec.reply(http.StatusOK, `{"access_token": "new-token"}`))
+
+	if _, err := ExchangeCode("client-id", "client-secret", "code123", "", WithHost(srv.URL), WithLogger(&l)); err != nil {
+		t.Fatalf("ExchangeCode: %v", err)
+	}
+	out := l.String()
+	for _, secret := range []string{"client-id", "client-secret", "code123"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log contains %q:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, `"grant_type":"authorization_code"`) {
+		t.Errorf("log doesn't contain the rest of the body:\n%s", out)
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	var rec recorder
+	var l bufferLogger
+	srv := newOAuthServer(t, rec.reply(http.StatusOK, `{"didRevoke": true}`))
+
+	if err := RevokeToken("id", "secret", "old-token", WithHost(srv.URL), WithLogger(&l)); err != nil {
+		t.Fatalf("RevokeToken: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/oauth/revoke_authorization")
+	if body := r.jsonBody(t); body["access_token"] != "old-token" || body["client_id"] != "id" || body["client_secret"] != "secret" {
+		t.Errorf("unexpected body %v", body)
+	}
+	if out := l.String(); strings.Contains(out, "old-token") || strings.Contains(out, `"secret"`) {
+		t.Errorf("log contains credentials:\n%s", out)
+	}
+}
+
+func TestRevokeTokenFailure(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+	}{
+		{http.StatusOK, `{"didRevoke": false}`},
+		{http.StatusUnauthorized, `{"error": "invalid_client", "error_description": "Invalid client credentials"}`},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		srv := newOAuthServer(t, rec.reply(tt.status, tt.body))
+
+		var e Error
+		if err := RevokeToken("id", "secret", "old-token", WithHost(srv.URL)); !errors.As(err, &e) {
+			t.Errorf("RevokeToken with a %d %s response error = %#v, want an Error", tt.status, tt.body, err)
+		}
+	}
+}
+
+func TestRevokeTokenCtxCancel(t *testing.T) {
+	var rec recorder
+	srv := newOAuthServer(t, rec.reply(http.StatusOK, `{"didRevoke": true}`))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := RevokeTokenCtx(ctx, "id", "secret", "old-token", WithHost(srv.URL)); !errors.Is(err, context.Canceled) {
+		t.Errorf("RevokeTokenCtx error = %v, want %v", err, context.Canceled)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
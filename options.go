@@ -0,0 +1,103 @@
+package webflow
+
+import "time"
+
+// Option configures a Webflow client created by NewClient.
+type Option func(*Webflow)
+
+// WithTimeout sets the timeout used on HTTP requests.
+func WithTimeout(d time.Duration) Option {
+	return func(m *Webflow) {
+		m.Timeout = d
+	}
+}
+
+// WithHost sets the host of Webflow's API.
+func WithHost(host string) Option {
+	return func(m *Webflow) {
+		m.Host = host
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, such as the name
+// and version of the application making them.
+func WithUserAgent(userAgent string) Option {
+	return func(m *Webflow) {
+		m.UserAgent = userAgent
+	}
+}
+
+// WithVersion sets the version used for API requests.
+func WithVersion(version string) Option {
+	return func(m *Webflow) {
+		m.Version = version
+	}
+}
+
+// WithDebug enables or disables debug mode.
+func WithDebug(debug bool) Option {
+	return func(m *Webflow) {
+		m.Debug = debug
+	}
+}
+
+// WithFileSystem sets the file system used to open files for uploads.
+func WithFileSystem(fo fileOpener) Option {
+	return func(m *Webflow) {
+		m.fs = fo
+	}
+}
+
+// WithLogger sets the logger requests are logged to.
+func WithLogger(l Logger) Option {
+	return func(m *Webflow) {
+		m.Logger = l
+	}
+}
+
+// WithAutoThrottle makes the client wait for the rate-limit window to reset once the
+// budget of requests runs out, instead of sending requests the API would reject.
+func WithAutoThrottle() Option {
+	return func(m *Webflow) {
+		m.AutoThrottle = true
+	}
+}
+
+// WithRateLimitTracking sets whether the client records the rate-limit headers of
+// responses, for proxies that strip them. AutoThrottle has no effect without it.
+func WithRateLimitTracking(track bool) Option {
+	return func(m *Webflow) {
+		m.TrackRateLimit = track
+	}
+}
+
+// WithAPIVersion sets the version of the API that requests are routed to.
+func WithAPIVersion(v APIVersion) Option {
+	return func(m *Webflow) {
+		m.APIVersion = v
+	}
+}
+
+// WithBasePath sets a path prefix that's prepended to the path of every request, for
+// gateways that serve the API below a path such as https://gw.internal/webflow.
+func WithBasePath(basePath string) Option {
+	return func(m *Webflow) {
+		m.BasePath = basePath
+	}
+}
+
+// WithDryRun makes the client log the requests it would make instead of sending them.
+// Requests made in dry-run mode fail with a DryRunError holding the request.
+func WithDryRun() Option {
+	return func(m *Webflow) {
+		m.DryRun = true
+	}
+}
+
+// WithETags makes the client send the ETag of the last response for a URL with GETs of
+// the same URL, returning ErrorNotModified when the API reports it unchanged.
+func WithETags() Option {
+	return func(m *Webflow) {
+		m.ETags = true
+	}
+}
@@ -0,0 +1,79 @@
+package webflow
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClientDefaults(t *testing.T) {
+	m, err := NewClient(testToken)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if m.Host != host || m.Version != defaultVersion || m.Timeout != defaultTimeout || m.Debug {
+		t.Errorf("unexpected defaults: host %q, version %q, timeout %s, debug %v", m.Host, m.Version, m.Timeout, m.Debug)
+	}
+	if _, err := NewClient(""); err == nil {
+		t.Error("NewClient without a token succeeded")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opt   Option
+		check func(*Webflow) bool
+	}{
+		{"WithTimeout", WithTimeout(30 * time.Second), func(m *Webflow) bool { return m.Timeout == 30*time.Second }},
+		{"WithHost", WithHost("https://gw.example.com"), func(m *Webflow) bool { return m.Host == "https://gw.example.com" }},
+		{"WithVersion", WithVersion("2.0.0"), func(m *Webflow) bool { return m.Version == "2.0.0" }},
+		{"WithDebug", WithDebug(true), func(m *Webflow) bool { return m.Debug }},
+	}
+	for _, tt := range tests {
+		m, err := NewClient(testToken, tt.opt)
+		if err != nil {
+			t.Fatalf("NewClient: %v", err)
+		}
+		if !tt.check(m) {
+			t.Errorf("%s didn't set its field: %+v", tt.name, m)
+		}
+	}
+}
+
+func TestWithFileSystem(t *testing.T) {
+	fs := fakeFS{"report.csv": "a,b\n"}
+	m, err := NewClient(testToken, WithFileSystem(fs))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	body, ct, err := m.generateMultipartRequestData(clientRequest{file: "report.csv"})
+	if err != nil {
+		t.Fatalf("generateMultipartRequestData: %v", err)
+	}
+	if !strings.HasPrefix(ct, "multipart/form-data") || !strings.Contains(string(body), "a,b\n") {
+		t.Errorf("body %q with content type %q doesn't hold the injected file", body, ct)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		opts []Option
+		want string
+	}{
+		{nil, "jumbletv-webflow-go/" + libraryVersion},
+		{[]Option{WithUserAgent("my-app/1.2")}, "my-app/1.2"},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `[]`), tt.opts...)
+
+		if _, err := m.ListSites(); err != nil {
+			t.Fatalf("ListSites: %v", err)
+		}
+		if got := rec.last(t).Header.Get("User-Agent"); got != tt.want {
+			t.Errorf("User-Agent = %q, want %q", got, tt.want)
+		}
+	}
+}
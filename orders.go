@@ -0,0 +1,190 @@
+package webflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Statuses of an e-commerce order.
+const (
+	OrderPending     = "pending"
+	OrderUnfulfilled = "unfulfilled"
+	OrderFulfilled   = "fulfilled"
+	OrderDisputed    = "disputed"
+	OrderDisputeLost = "dispute-lost"
+	OrderRefunded    = "refunded"
+)
+
+// orderStatuses is the set of order statuses known to the API.
+var orderStatuses = map[string]bool{
+	OrderPending:     true,
+	OrderUnfulfilled: true,
+	OrderFulfilled:   true,
+	OrderDisputed:    true,
+	OrderDisputeLost: true,
+	OrderRefunded:    true,
+}
+
+// Order defines an e-commerce order placed on a site.
+type Order struct {
+	OrderID          string          `json:"orderId"`
+	Status           string          `json:"status"`
+	Comment          string          `json:"comment"`
+	CustomerPaid     Price           `json:"customerPaid"`
+	NetAmount        Price           `json:"netAmount"`
+	PurchasedItems   []PurchasedItem `json:"purchasedItems"`
+	CustomerInfo     CustomerInfo    `json:"customerInfo"`
+	ShippingAddress  Address         `json:"shippingAddress"`
+	BillingAddress   Address         `json:"billingAddress"`
+	ShippingProvider string          `json:"shippingProvider"`
+	ShippingTracking string          `json:"shippingTracking"`
+	AcceptedOn       time.Time       `json:"acceptedOn"`
+	FulfilledOn      time.Time       `json:"fulfilledOn"`
+	RefundedOn       time.Time       `json:"refundedOn"`
+}
+
+// CustomerInfo defines the customer that placed an order.
+type CustomerInfo struct {
+	FullName string `json:"fullName"`
+	Email    string `json:"email"`
+}
+
+// Address defines a shipping or billing address of an order.
+type Address struct {
+	Type       string `json:"type"`
+	Addressee  string `json:"addressee"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	State      string `json:"state"`
+	Country    string `json:"country"`
+	PostalCode string `json:"postalCode"`
+}
+
+// PurchasedItem defines a line item of an order.
+type PurchasedItem struct {
+	Count        int    `json:"count"`
+	RowTotal     Price  `json:"rowTotal"`
+	ProductID    string `json:"productId"`
+	ProductName  string `json:"productName"`
+	VariantID    string `json:"variantId"`
+	VariantName  string `json:"variantName"`
+	VariantSKU   string `json:"variantSKU"`
+	VariantPrice Price  `json:"variantPrice"`
+}
+
+// ListOrders returns a page of orders of the site, filtered by status unless status is
+// empty.
+func (m *Webflow) ListOrders(siteID string, status string, p Param) ([]Order, error) {
+	return m.ListOrdersCtx(context.Background(), siteID, status, p)
+}
+
+// ListOrdersCtx is like ListOrders but uses ctx for the request.
+func (m *Webflow) ListOrdersCtx(ctx context.Context, siteID string, status string, p Param) ([]Order, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	filter := url.Values{}
+	if status != "" {
+		if !orderStatuses[status] {
+			return nil, fmt.Errorf("unknown webflow order status %q", status)
+		}
+		filter.Set("status", status)
+	}
+	path := withQuery(fmt.Sprintf("/sites/%s/orders", siteID), p.toQuery(), filter)
+	var orders []Order
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   path,
+	}, &orders); err != nil {
+		if isEmptyListError(err) {
+			return []Order{}, nil
+		}
+		return nil, err
+	}
+	return orders, nil
+}
+
+// GetOrder returns the order with the given ID. An order that doesn't exist is
+// returned as an Error with a 404 code.
+func (m *Webflow) GetOrder(siteID, orderID string) (*Order, error) {
+	return m.GetOrderCtx(context.Background(), siteID, orderID)
+}
+
+// GetOrderCtx is like GetOrder but uses ctx for the request.
+func (m *Webflow) GetOrderCtx(ctx context.Context, siteID, orderID string) (*Order, error) {
+	return m.order(ctx, http.MethodGet, siteID, orderID, "", nil)
+}
+
+// UpdateOrder changes the given editable fields of the order, such as comment,
+// shippingProvider and shippingTracking. Fields not in the map are left as they are.
+func (m *Webflow) UpdateOrder(siteID, orderID string, fields map[string]interface{}) (*Order, error) {
+	return m.UpdateOrderCtx(context.Background(), siteID, orderID, fields)
+}
+
+// UpdateOrderCtx is like UpdateOrder but uses ctx for the request.
+func (m *Webflow) UpdateOrderCtx(ctx context.Context, siteID, orderID string, fields map[string]interface{}) (*Order, error) {
+	return m.order(ctx, http.MethodPatch, siteID, orderID, "", map[string]interface{}{
+		"fields": fields,
+	})
+}
+
+// FulfillOrder marks the order as fulfilled, emailing the customer that their order
+// was fulfilled when notifyCustomer is true.
+func (m *Webflow) FulfillOrder(siteID, orderID string, notifyCustomer bool) (*Order, error) {
+	return m.FulfillOrderCtx(context.Background(), siteID, orderID, notifyCustomer)
+}
+
+// FulfillOrderCtx is like FulfillOrder but uses ctx for the request.
+func (m *Webflow) FulfillOrderCtx(ctx context.Context, siteID, orderID string, notifyCustomer bool) (*Order, error) {
+	return m.order(ctx, http.MethodPost, siteID, orderID, "fulfill", map[string]interface{}{
+		"sendOrderFulfilledEmail": notifyCustomer,
+	})
+}
+
+// UnfulfillOrder marks the order as unfulfilled.
+func (m *Webflow) UnfulfillOrder(siteID, orderID string) (*Order, error) {
+	return m.UnfulfillOrderCtx(context.Background(), siteID, orderID)
+}
+
+// UnfulfillOrderCtx is like UnfulfillOrder but uses ctx for the request.
+func (m *Webflow) UnfulfillOrderCtx(ctx context.Context, siteID, orderID string) (*Order, error) {
+	return m.order(ctx, http.MethodPost, siteID, orderID, "unfulfill", nil)
+}
+
+// RefundOrder refunds the order. An order that can't be refunded, such as one that
+// was already refunded, is returned as an Error with a 400 code.
+func (m *Webflow) RefundOrder(siteID, orderID string) (*Order, error) {
+	return m.RefundOrderCtx(context.Background(), siteID, orderID)
+}
+
+// RefundOrderCtx is like RefundOrder but uses ctx for the request.
+func (m *Webflow) RefundOrderCtx(ctx context.Context, siteID, orderID string) (*Order, error) {
+	return m.order(ctx, http.MethodPost, siteID, orderID, "refund", nil)
+}
+
+// order makes a request to the order, or to the given action of the order.
+func (m *Webflow) order(ctx context.Context, method, siteID, orderID, action string, data interface{}) (*Order, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if orderID == "" {
+		return nil, ErrorMissingOrderID
+	}
+	path := fmt.Sprintf("/sites/%s/order/%s", siteID, orderID)
+	if action != "" {
+		path += "/" + action
+	}
+	var order Order
+	if err := m.requestCtx(ctx, clientRequest{
+		method: method,
+		path:   path,
+		data:   data,
+	}, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
@@ -0,0 +1,197 @@
+package webflow
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateOrder(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"orderId": "o1", "status": "unfulfilled", "comment": "Leave at the door"}`))
+
+	order, err := m.UpdateOrder("s1", "o1", map[string]interface{}{"comment": "Leave at the door"})
+	if err != nil {
+		t.Fatalf("UpdateOrder: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPatch, "/sites/s1/order/o1")
+	want := map[string]interface{}{"fields": map[string]interface{}{"comment": "Leave at the door"}}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+	if order.Comment != "Leave at the door" {
+		t.Errorf("order comment = %q", order.Comment)
+	}
+}
+
+func TestFulfillOrder(t *testing.T) {
+	for _, notify := range []bool{false, true} {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{"orderId": "o1", "status": "fulfilled", "fulfilledOn": "2022-03-04T05:06:07Z"}`))
+
+		order, err := m.FulfillOrder("s1", "o1", notify)
+		if err != nil {
+			t.Fatalf("FulfillOrder(notify=%v): %v", notify, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPost, "/sites/s1/order/o1/fulfill")
+		if got := r.jsonBody(t)["sendOrderFulfilledEmail"]; got != notify {
+			t.Errorf("sendOrderFulfilledEmail = %v, want %v", got, notify)
+		}
+		if order.Status != "fulfilled" || order.FulfilledOn.IsZero() {
+			t.Errorf("unexpected order %+v", order)
+		}
+	}
+}
+
+func TestUnfulfillOrder(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"orderId": "o1", "status": "unfulfilled"}`))
+
+	order, err := m.UnfulfillOrder("s1", "o1")
+	if err != nil {
+		t.Fatalf("UnfulfillOrder: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodPost, "/sites/s1/order/o1/unfulfill")
+	if order.Status != "unfulfilled" {
+		t.Errorf("order status = %q, want unfulfilled", order.Status)
+	}
+}
+
+func TestRefundOrder(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"orderId": "o1", "status": "refunded", "refundedOn": "2022-03-04T05:06:07Z"}`))
+
+	order, err := m.RefundOrder("s1", "o1")
+	if err != nil {
+		t.Fatalf("RefundOrder: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodPost, "/sites/s1/order/o1/refund")
+	if order.Status != "refunded" || order.RefundedOn.IsZero() {
+		t.Errorf("unexpected order %+v", order)
+	}
+}
+
+func TestRefundOrderAlreadyRefunded(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusBadRequest, `{"msg": "Order has already been refunded", "code": 400, "name": "OrderRefunded"}`))
+
+	_, err := m.RefundOrder("s1", "o1")
+	var e Error
+	if !errors.As(err, &e) || e.Status != http.StatusBadRequest || e.Message != "Order has already been refunded" {
+		t.Errorf("RefundOrder error = %#v, want a 400 Error", err)
+	}
+}
+
+func TestListOrders(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[{"orderId": "o1", "status": "unfulfilled"}, {"orderId": "o2", "status": "unfulfilled"}]`))
+
+	orders, err := m.ListOrders("s1", OrderUnfulfilled, Param{Page: 3, PerPage: 20})
+	if err != nil {
+		t.Fatalf("ListOrders: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodGet, "/sites/s1/orders")
+	want := url.Values{"status": {"unfulfilled"}, "limit": {"20"}, "offset": {"40"}}
+	if !reflect.DeepEqual(r.Query, want) {
+		t.Errorf("query = %v, want %v", r.Query, want)
+	}
+	if len(orders) != 2 || orders[1].OrderID != "o2" {
+		t.Errorf("unexpected orders %+v", orders)
+	}
+
+	if _, err := m.ListOrders("s1", "", Param{}); err != nil {
+		t.Fatalf("ListOrders without a status: %v", err)
+	}
+	if q := rec.last(t).Query; len(q) != 0 {
+		t.Errorf("query without a status or page = %v, want none", q)
+	}
+}
+
+func TestListOrdersInvalidStatus(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[]`))
+
+	if _, err := m.ListOrders("s1", "shipped", Param{}); err == nil {
+		t.Error("ListOrders with an unknown status succeeded")
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+// orderBody is a full order as the API returns it.
+const orderBody = `{
+	"orderId": "o1",
+	"status": "unfulfilled",
+	"customerPaid": {"value": 5497, "unit": "USD"},
+	"netAmount": {"value": 5108, "unit": "USD"},
+	"customerInfo": {"fullName": "Jane Doe", "email": "jane@example.com"},
+	"shippingAddress": {"type": "shipping", "addressee": "Jane Doe", "line1": "1 Main St", "line2": "Apt 2", "city": "Springfield", "state": "OR", "country": "US", "postalCode": "97477"},
+	"purchasedItems": [
+		{"count": 2, "rowTotal": {"value": 3998, "unit": "USD"}, "productId": "p1", "productName": "Shirt", "variantId": "k1", "variantName": "Medium", "variantSKU": "SHIRT-M", "variantPrice": {"value": 1999, "unit": "USD"}},
+		{"count": 1, "rowTotal": {"value": 1499, "unit": "USD"}, "productId": "p2", "productName": "Hat", "variantId": "k2", "variantName": "One size", "variantSKU": "HAT", "variantPrice": {"value": 1499, "unit": "USD"}}
+	],
+	"acceptedOn": "2022-03-04T05:06:07Z"
+}`
+
+func TestGetOrder(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, orderBody))
+
+	order, err := m.GetOrder("s1", "o1")
+	if err != nil {
+		t.Fatalf("GetOrder: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1/order/o1")
+	if order.OrderID != "o1" || order.Status != OrderUnfulfilled || order.CustomerInfo.Email != "jane@example.com" {
+		t.Errorf("unexpected order %+v", order)
+	}
+	if order.CustomerPaid != (Price{Value: 5497, Unit: "USD"}) || order.NetAmount != (Price{Value: 5108, Unit: "USD"}) {
+		t.Errorf("amounts = %+v, %+v, want 5497 and 5108 USD", order.CustomerPaid, order.NetAmount)
+	}
+	wantAddress := Address{Type: "shipping", Addressee: "Jane Doe", Line1: "1 Main St", Line2: "Apt 2", City: "Springfield", State: "OR", Country: "US", PostalCode: "97477"}
+	if order.ShippingAddress != wantAddress {
+		t.Errorf("shipping address = %+v, want %+v", order.ShippingAddress, wantAddress)
+	}
+	wantItems := []PurchasedItem{
+		{Count: 2, RowTotal: Price{Value: 3998, Unit: "USD"}, ProductID: "p1", ProductName: "Shirt", VariantID: "k1", VariantName: "Medium", VariantSKU: "SHIRT-M", VariantPrice: Price{Value: 1999, Unit: "USD"}},
+		{Count: 1, RowTotal: Price{Value: 1499, Unit: "USD"}, ProductID: "p2", ProductName: "Hat", VariantID: "k2", VariantName: "One size", VariantSKU: "HAT", VariantPrice: Price{Value: 1499, Unit: "USD"}},
+	}
+	if !reflect.DeepEqual(order.PurchasedItems, wantItems) {
+		t.Errorf("purchased items = %+v, want %+v", order.PurchasedItems, wantItems)
+	}
+	if order.AcceptedOn.IsZero() || !order.FulfilledOn.IsZero() {
+		t.Errorf("AcceptedOn = %v, FulfilledOn = %v", order.AcceptedOn, order.FulfilledOn)
+	}
+}
+
+func TestGetOrderNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, `{"msg": "Order not found", "code": 404, "name": "NotFound"}`))
+
+	_, err := m.GetOrder("s1", "o9")
+	var e *Error
+	if !errors.As(err, &e) || e.Status != http.StatusNotFound || e.Code != 404 {
+		t.Errorf("GetOrder of a missing order error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestGetOrderMissingIDs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, orderBody))
+
+	if _, err := m.GetOrder("", "o1"); err != ErrorMissingSiteID {
+		t.Errorf("GetOrder without a site error = %v, want %v", err, ErrorMissingSiteID)
+	}
+	if _, err := m.GetOrder("s1", ""); err != ErrorMissingOrderID {
+		t.Errorf("GetOrder without an order error = %v, want %v", err, ErrorMissingOrderID)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
@@ -0,0 +1,98 @@
+package webflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// FormSubmissionEvent defines the payload of a form_submission webhook.
+type FormSubmissionEvent struct {
+	ID          string                 `json:"_id"`
+	Name        string                 `json:"name"`
+	SiteID      string                 `json:"site"`
+	Data        map[string]interface{} `json:"data"`
+	SubmittedAt time.Time              `json:"d"`
+}
+
+// SitePublishEvent defines the payload of a site_publish webhook.
+type SitePublishEvent struct {
+	SiteID      string   `json:"site"`
+	PublishTime int64    `json:"publishTime"`
+	Domains     []string `json:"domains"`
+	PublishedBy struct {
+		Name string `json:"displayName"`
+	} `json:"publishedBy"`
+}
+
+// PublishedAt returns the time the site was published.
+func (e SitePublishEvent) PublishedAt() time.Time {
+	return time.Unix(0, e.PublishTime*int64(time.Millisecond))
+}
+
+// OrderEvent defines the payload of the ecomm_new_order and ecomm_order_changed
+// webhooks, which carry the order.
+type OrderEvent struct {
+	Order
+}
+
+// InventoryEvent defines the payload of an ecomm_inventory_changed webhook.
+type InventoryEvent struct {
+	Inventory
+}
+
+// CollectionItemEvent defines the payload of the collection item webhooks. Created
+// and changed events carry the whole item, while deleted and unpublished events carry
+// only its ID.
+type CollectionItemEvent struct {
+	ItemID  string
+	Item    *Item
+	Deleted int
+}
+
+// UnmarshalJSON decodes the payload of any of the collection item webhooks.
+func (e *CollectionItemEvent) UnmarshalJSON(b []byte) error {
+	var ref struct {
+		ItemID  string `json:"itemId"`
+		Deleted int    `json:"deleted"`
+	}
+	if err := json.Unmarshal(b, &ref); err != nil {
+		return err
+	}
+	if ref.ItemID != "" {
+		*e = CollectionItemEvent{ItemID: ref.ItemID, Deleted: ref.Deleted}
+		return nil
+	}
+	var item Item
+	if err := json.Unmarshal(b, &item); err != nil {
+		return err
+	}
+	*e = CollectionItemEvent{ItemID: item.ID, Item: &item}
+	return nil
+}
+
+// ParseWebhookPayload decodes the body of a webhook of the given trigger type. The
+// result is a *FormSubmissionEvent, *SitePublishEvent, *OrderEvent, *InventoryEvent or
+// *CollectionItemEvent depending on the trigger type.
+func ParseWebhookPayload(triggerType string, body []byte) (interface{}, error) {
+	var event interface{}
+	switch triggerType {
+	case TriggerFormSubmission:
+		event = &FormSubmissionEvent{}
+	case TriggerSitePublish:
+		event = &SitePublishEvent{}
+	case TriggerEcommNewOrder, TriggerEcommOrderChanged:
+		event = &OrderEvent{}
+	case TriggerEcommInventoryChanged:
+		event = &InventoryEvent{}
+	case TriggerCollectionItemCreated, TriggerCollectionItemChanged,
+		TriggerCollectionItemDeleted, TriggerCollectionItemUnpublished:
+		event = &CollectionItemEvent{}
+	default:
+		return nil, fmt.Errorf("unknown webflow webhook trigger type %q", triggerType)
+	}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("could not parse webflow %s payload: %s", triggerType, err)
+	}
+	return event, nil
+}
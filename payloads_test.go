@@ -0,0 +1,101 @@
+package webflow
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWebhookPayload(t *testing.T) {
+	tests := []struct {
+		triggerType string
+		body        string
+		check       func(t *testing.T, event interface{})
+	}{
+		{TriggerFormSubmission, `{"_id": "fs1", "name": "Contact", "site": "s1", "data": {"email": "user@example.com"}, "d": "2022-04-05T06:07:08.000Z"}`, func(t *testing.T, event interface{}) {
+			e := event.(*FormSubmissionEvent)
+			if e.ID != "fs1" || e.Name != "Contact" || e.SiteID != "s1" || e.Data["email"] != "user@example.com" {
+				t.Errorf("unexpected event %+v", e)
+			}
+			if !e.SubmittedAt.Equal(time.Date(2022, 4, 5, 6, 7, 8, 0, time.UTC)) {
+				t.Errorf("SubmittedAt = %v", e.SubmittedAt)
+			}
+		}},
+		{TriggerSitePublish, `{"site": "s1", "publishTime": 1649138828000, "domains": ["example.com"], "publishedBy": {"displayName": "Jane"}}`, func(t *testing.T, event interface{}) {
+			e := event.(*SitePublishEvent)
+			if e.SiteID != "s1" || len(e.Domains) != 1 || e.PublishedBy.Name != "Jane" {
+				t.Errorf("unexpected event %+v", e)
+			}
+			if !e.PublishedAt().Equal(time.Date(2022, 4, 5, 6, 7, 8, 0, time.UTC)) {
+				t.Errorf("PublishedAt() = %v", e.PublishedAt())
+			}
+		}},
+		{TriggerEcommNewOrder, `{"orderId": "o1", "status": "unfulfilled", "customerPaid": {"value": 1999, "unit": "USD"}}`, func(t *testing.T, event interface{}) {
+			e := event.(*OrderEvent)
+			if e.OrderID != "o1" || e.CustomerPaid.Value != 1999 {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+		{TriggerEcommInventoryChanged, `{"_id": "k1", "quantity": 3, "inventoryType": "finite"}`, func(t *testing.T, event interface{}) {
+			e := event.(*InventoryEvent)
+			if e.ID != "k1" || e.Quantity != 3 {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+		{TriggerCollectionItemChanged, `{"_id": "i1", "_cid": "c1", "slug": "first", "name": "First"}`, func(t *testing.T, event interface{}) {
+			e := event.(*CollectionItemEvent)
+			if e.ItemID != "i1" || e.Item == nil || e.Item.Slug != "first" {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+		{TriggerEcommOrderChanged, `{"orderId": "o1", "status": "fulfilled", "shippingTracking": "1Z999", "purchasedItems": [{"count": 2, "productId": "p1"}]}`, func(t *testing.T, event interface{}) {
+			e, ok := event.(*OrderEvent)
+			if !ok {
+				t.Fatalf("event = %T, want *OrderEvent", event)
+			}
+			if e.OrderID != "o1" || e.Status != OrderFulfilled || e.ShippingTracking != "1Z999" || len(e.PurchasedItems) != 1 || e.PurchasedItems[0].Count != 2 {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+		{TriggerCollectionItemCreated, `{"_id": "i2", "_cid": "c1", "slug": "second", "name": "Second", "_draft": true}`, func(t *testing.T, event interface{}) {
+			e, ok := event.(*CollectionItemEvent)
+			if !ok {
+				t.Fatalf("event = %T, want *CollectionItemEvent", event)
+			}
+			if e.ItemID != "i2" || e.Item == nil || e.Item.CollectionID != "c1" || !e.Item.Draft || e.Item.Fields["name"] != "Second" {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+		{TriggerCollectionItemUnpublished, `{"itemId": "i3"}`, func(t *testing.T, event interface{}) {
+			e, ok := event.(*CollectionItemEvent)
+			if !ok {
+				t.Fatalf("event = %T, want *CollectionItemEvent", event)
+			}
+			if e.ItemID != "i3" || e.Item != nil || e.Deleted != 0 {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+		{TriggerCollectionItemDeleted, `{"deleted": 1, "itemId": "i1"}`, func(t *testing.T, event interface{}) {
+			e := event.(*CollectionItemEvent)
+			if e.ItemID != "i1" || e.Item != nil || e.Deleted != 1 {
+				t.Errorf("unexpected event %+v", e)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		event, err := ParseWebhookPayload(tt.triggerType, []byte(tt.body))
+		if err != nil {
+			t.Errorf("ParseWebhookPayload(%s): %v", tt.triggerType, err)
+			continue
+		}
+		tt.check(t, event)
+	}
+}
+
+func TestParseWebhookPayloadErrors(t *testing.T) {
+	if _, err := ParseWebhookPayload("unknown", []byte(`{}`)); err == nil {
+		t.Error("ParseWebhookPayload of an unknown trigger type succeeded")
+	}
+	if _, err := ParseWebhookPayload(TriggerSitePublish, []byte(`{`)); err == nil {
+		t.Error("ParseWebhookPayload of an invalid body succeeded")
+	}
+}
@@ -0,0 +1,37 @@
+package webflow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// zeroDecimalCurrencies is the set of currencies whose amounts have no minor unit.
+var zeroDecimalCurrencies = map[string]bool{
+	"BIF": true, "CLP": true, "DJF": true, "GNF": true, "JPY": true, "KMF": true,
+	"KRW": true, "MGA": true, "PYG": true, "RWF": true, "UGX": true, "VND": true,
+	"VUV": true, "XAF": true, "XOF": true, "XPF": true,
+}
+
+// Price defines a monetary amount. Value is given in the smallest unit of the currency,
+// e.g. cents for USD, and Unit is the currency code.
+type Price struct {
+	Value int    `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+// Dollars returns the amount in the major unit of the currency, e.g. 19.99 for a
+// value of 1999 USD. Amounts of zero-decimal currencies such as JPY are returned as is.
+func (p Price) Dollars() float64 {
+	if zeroDecimalCurrencies[strings.ToUpper(p.Unit)] {
+		return float64(p.Value)
+	}
+	return float64(p.Value) / 100
+}
+
+// String returns the amount in the major unit followed by the currency code.
+func (p Price) String() string {
+	if zeroDecimalCurrencies[strings.ToUpper(p.Unit)] {
+		return fmt.Sprintf("%d %s", p.Value, p.Unit)
+	}
+	return fmt.Sprintf("%.2f %s", p.Dollars(), p.Unit)
+}
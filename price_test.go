@@ -0,0 +1,24 @@
+package webflow
+
+import "testing"
+
+func TestPrice(t *testing.T) {
+	tests := []struct {
+		price   Price
+		dollars float64
+		str     string
+	}{
+		{Price{Value: 1999, Unit: "USD"}, 19.99, "19.99 USD"},
+		{Price{Value: 5, Unit: "EUR"}, 0.05, "0.05 EUR"},
+		{Price{Value: 1999, Unit: "JPY"}, 1999, "1999 JPY"},
+		{Price{Value: 500, Unit: "krw"}, 500, "500 krw"},
+	}
+	for _, tt := range tests {
+		if got := tt.price.Dollars(); got != tt.dollars {
+			t.Errorf("%+v.Dollars() = %v, want %v", tt.price, got, tt.dollars)
+		}
+		if got := tt.price.String(); got != tt.str {
+			t.Errorf("%+v.String() = %q, want %q", tt.price, got, tt.str)
+		}
+	}
+}
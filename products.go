@@ -0,0 +1,345 @@
+package webflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Product defines an e-commerce product along with its SKUs.
+type Product struct {
+	ID           string `json:"_id"`
+	DefaultSKUID string `json:"default-sku"`
+	// Fields holds every field of the product keyed by slug, including the ones above.
+	// Numbers are held as json.Number; use its Int64 or Float64 method to read them.
+	Fields map[string]interface{} `json:"-"`
+	// SKUs holds the product's default SKU and its variants.
+	SKUs []SKU `json:"-"`
+}
+
+// SKU defines a purchasable variant of a product.
+type SKU struct {
+	ID             string `json:"_id"`
+	ProductID      string `json:"product"`
+	Price          Price  `json:"price"`
+	CompareAtPrice *Price `json:"compare-at-price"`
+	// Fields holds every field of the SKU keyed by slug, including the ones above.
+	// Numbers are held as json.Number; use its Int64 or Float64 method to read them.
+	Fields map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON decodes a product returned together with its SKUs.
+func (p *Product) UnmarshalJSON(b []byte) error {
+	var res struct {
+		Product json.RawMessage `json:"product"`
+		SKUs    []SKU           `json:"skus"`
+		SKU     *SKU            `json:"sku"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+	if res.Product == nil {
+		res.Product = b
+	}
+	type product Product
+	var pr product
+	if err := decodeFields(res.Product, &pr, &pr.Fields); err != nil {
+		return err
+	}
+	pr.SKUs = res.SKUs
+	if res.SKU != nil {
+		pr.SKUs = append(pr.SKUs, *res.SKU)
+	}
+	*p = Product(pr)
+	return nil
+}
+
+// UnmarshalJSON decodes the standard SKU fields as well as the dynamic CMS fields.
+func (s *SKU) UnmarshalJSON(b []byte) error {
+	type sku SKU
+	var sk sku
+	if err := decodeFields(b, &sk, &sk.Fields); err != nil {
+		return err
+	}
+	*s = SKU(sk)
+	return nil
+}
+
+// DefaultSKU returns the default SKU of the product, or false when it isn't among the
+// product's loaded SKUs.
+func DefaultSKU(p *Product) (*SKU, bool) {
+	if p == nil || p.DefaultSKUID == "" {
+		return nil, false
+	}
+	for i := range p.SKUs {
+		if p.SKUs[i].ID == p.DefaultSKUID {
+			return &p.SKUs[i], true
+		}
+	}
+	return nil, false
+}
+
+// ListProducts returns a page of products of the site along with the total number of
+// products.
+func (m *Webflow) ListProducts(siteID string, p Param) ([]Product, int, error) {
+	return m.ListProductsCtx(context.Background(), siteID, p)
+}
+
+// ListProductsCtx is like ListProducts but uses ctx for the request.
+func (m *Webflow) ListProductsCtx(ctx context.Context, siteID string, p Param) ([]Product, int, error) {
+	products, page, err := m.ListProductsPageCtx(ctx, siteID, p)
+	return products, page.Total, err
+}
+
+// ListProductsPage returns a page of products of the site along with the pagination
+// metadata of the page. A site without products is returned as an empty page.
+func (m *Webflow) ListProductsPage(siteID string, p Param) ([]Product, Pagination, error) {
+	return m.ListProductsPageCtx(context.Background(), siteID, p)
+}
+
+// ListProductsPageCtx is like ListProductsPage but uses ctx for the request.
+func (m *Webflow) ListProductsPageCtx(ctx context.Context, siteID string, p Param) ([]Product, Pagination, error) {
+	if siteID == "" {
+		return nil, Pagination{}, ErrorMissingSiteID
+	}
+	path := withQuery(fmt.Sprintf("/sites/%s/products", siteID), p.toQuery())
+	var res struct {
+		Items []Product `json:"items"`
+		listPage
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   path,
+	}, &res); err != nil {
+		if isEmptyListError(err) {
+			return []Product{}, Pagination{}, nil
+		}
+		return nil, Pagination{}, err
+	}
+	return res.Items, res.pagination(len(res.Items)), nil
+}
+
+// ListAllSKUs returns the SKUs of every product of the site, fetching as many pages of
+// products as needed. Each SKU carries the ID of its product.
+func (m *Webflow) ListAllSKUs(siteID string) ([]SKU, error) {
+	return m.ListAllSKUsCtx(context.Background(), siteID)
+}
+
+// ListAllSKUsCtx is like ListAllSKUs but uses ctx for the requests.
+func (m *Webflow) ListAllSKUsCtx(ctx context.Context, siteID string) ([]SKU, error) {
+	var skus []SKU
+	fetched := 0
+	for page := 1; ; page++ {
+		products, p, err := m.ListProductsPageCtx(ctx, siteID, Param{Page: page, PerPage: maxPerPage})
+		if err != nil {
+			return nil, err
+		}
+		for _, product := range products {
+			for _, sku := range product.SKUs {
+				if sku.ProductID == "" {
+					sku.ProductID = product.ID
+				}
+				skus = append(skus, sku)
+			}
+		}
+		fetched += len(products)
+		if len(products) == 0 || fetched >= p.Total {
+			return skus, nil
+		}
+	}
+}
+
+// GetProduct returns the product with the given ID along with all of its SKUs.
+func (m *Webflow) GetProduct(siteID, productID string) (*Product, error) {
+	return m.GetProductCtx(context.Background(), siteID, productID)
+}
+
+// GetProductCtx is like GetProduct but uses ctx for the request.
+func (m *Webflow) GetProductCtx(ctx context.Context, siteID, productID string) (*Product, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if productID == "" {
+		return nil, ErrorMissingProductID
+	}
+	var product Product
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s/products/%s", siteID, productID),
+	}, &product); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// CreateProduct creates a product on the site together with its default SKU. The
+// product fields must include a name and a slug and the SKU fields must include a price.
+func (m *Webflow) CreateProduct(siteID string, product map[string]interface{}, sku map[string]interface{}) (*Product, error) {
+	return m.CreateProductCtx(context.Background(), siteID, product, sku)
+}
+
+// CreateProductCtx is like CreateProduct but uses ctx for the request.
+func (m *Webflow) CreateProductCtx(ctx context.Context, siteID string, product map[string]interface{}, sku map[string]interface{}) (*Product, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	for _, k := range []string{"name", "slug"} {
+		if _, ok := product[k]; !ok {
+			return nil, fmt.Errorf("missing webflow product field %q", k)
+		}
+	}
+	if _, ok := sku["price"]; !ok {
+		return nil, fmt.Errorf("missing webflow sku field %q", "price")
+	}
+	var p Product
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/sites/%s/products", siteID),
+		data: map[string]interface{}{
+			"product": map[string]interface{}{
+				"fields": product,
+			},
+			"sku": map[string]interface{}{
+				"fields": sku,
+			},
+		},
+		idempotencyKey: newIdempotencyKey(),
+	}, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// CreateSKUs adds the SKUs with the given fields to the product, as variants next to
+// its default SKU. The fields of every SKU must include a price.
+func (m *Webflow) CreateSKUs(siteID, productID string, skus []map[string]interface{}) ([]SKU, error) {
+	return m.CreateSKUsCtx(context.Background(), siteID, productID, skus)
+}
+
+// CreateSKUsCtx is like CreateSKUs but uses ctx for the request.
+func (m *Webflow) CreateSKUsCtx(ctx context.Context, siteID, productID string, skus []map[string]interface{}) ([]SKU, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if productID == "" {
+		return nil, ErrorMissingProductID
+	}
+	data := make([]map[string]interface{}, len(skus))
+	for i, sku := range skus {
+		if _, ok := sku["price"]; !ok {
+			return nil, fmt.Errorf("missing webflow sku field %q in sku %d", "price", i)
+		}
+		data[i] = map[string]interface{}{
+			"fields": sku,
+		}
+	}
+	var res struct {
+		SKUs []SKU `json:"skus"`
+	}
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/sites/%s/products/%s/skus", siteID, productID),
+		data: map[string]interface{}{
+			"skus": data,
+		},
+		idempotencyKey: newIdempotencyKey(),
+	}, &res); err != nil {
+		return nil, err
+	}
+	return res.SKUs, nil
+}
+
+// UpdateSKU changes the given fields of the SKU. Prices are given as an object with
+// the amount in the smallest unit of the currency, e.g. cents, and the currency code:
+//
+//	map[string]interface{}{"price": map[string]interface{}{"value": 1999, "unit": "USD"}}
+func (m *Webflow) UpdateSKU(siteID, productID, skuID string, fields map[string]interface{}) (*SKU, error) {
+	return m.UpdateSKUCtx(context.Background(), siteID, productID, skuID, fields)
+}
+
+// UpdateSKUCtx is like UpdateSKU but uses ctx for the request.
+func (m *Webflow) UpdateSKUCtx(ctx context.Context, siteID, productID, skuID string, fields map[string]interface{}) (*SKU, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if productID == "" {
+		return nil, ErrorMissingProductID
+	}
+	if skuID == "" {
+		return nil, ErrorMissingSKUID
+	}
+	var sku SKU
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodPatch,
+		path:   fmt.Sprintf("/sites/%s/products/%s/skus/%s", siteID, productID, skuID),
+		data: map[string]interface{}{
+			"sku": map[string]interface{}{
+				"fields": fields,
+			},
+		},
+	}, &sku); err != nil {
+		return nil, err
+	}
+	return &sku, nil
+}
+
+// Inventory types of a SKU.
+const (
+	InventoryFinite   = "finite"
+	InventoryInfinite = "infinite"
+)
+
+// Inventory defines the stock of a SKU.
+type Inventory struct {
+	ID            string `json:"_id"`
+	Quantity      int    `json:"quantity"`
+	InventoryType string `json:"inventoryType"`
+}
+
+// GetInventory returns the inventory of the SKU item.
+func (m *Webflow) GetInventory(collectionID, itemID string) (*Inventory, error) {
+	return m.GetInventoryCtx(context.Background(), collectionID, itemID)
+}
+
+// GetInventoryCtx is like GetInventory but uses ctx for the request.
+func (m *Webflow) GetInventoryCtx(ctx context.Context, collectionID, itemID string) (*Inventory, error) {
+	return m.inventory(ctx, http.MethodGet, collectionID, itemID, nil)
+}
+
+// UpdateInventory sets the inventory of the SKU item to quantity, or to an unlimited
+// stock when infinite is true in which case quantity is ignored.
+func (m *Webflow) UpdateInventory(collectionID, itemID string, quantity int, infinite bool) (*Inventory, error) {
+	return m.UpdateInventoryCtx(context.Background(), collectionID, itemID, quantity, infinite)
+}
+
+// UpdateInventoryCtx is like UpdateInventory but uses ctx for the request.
+func (m *Webflow) UpdateInventoryCtx(ctx context.Context, collectionID, itemID string, quantity int, infinite bool) (*Inventory, error) {
+	data := map[string]interface{}{
+		"inventoryType": InventoryInfinite,
+	}
+	if !infinite {
+		data["inventoryType"] = InventoryFinite
+		data["quantity"] = quantity
+	}
+	return m.inventory(ctx, http.MethodPatch, collectionID, itemID, data)
+}
+
+// inventory makes a request to the inventory of the SKU item.
+func (m *Webflow) inventory(ctx context.Context, method, collectionID, itemID string, data interface{}) (*Inventory, error) {
+	if collectionID == "" {
+		return nil, ErrorMissingCollectionID
+	}
+	if itemID == "" {
+		return nil, ErrorMissingItemID
+	}
+	var inventory Inventory
+	if err := m.requestCtx(ctx, clientRequest{
+		method: method,
+		path:   fmt.Sprintf("/collections/%s/items/%s/inventory", collectionID, itemID),
+		data:   data,
+	}, &inventory); err != nil {
+		return nil, err
+	}
+	return &inventory, nil
+}
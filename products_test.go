@@ -0,0 +1,268 @@
+package webflow
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// productBody is a product returned together with its two SKUs.
+const productBody = `{
+	"product": {"_id": "p1", "name": "Shirt", "slug": "shirt", "default-sku": "k1", "shippable": true},
+	"skus": [
+		{"_id": "k1", "product": "p1", "name": "Medium", "price": {"value": 1999, "unit": "USD"}},
+		{"_id": "k2", "product": "p1", "name": "Large", "price": {"value": 2499, "unit": "USD"}, "compare-at-price": {"value": 2999, "unit": "USD"}}
+	]
+}`
+
+func TestGetProduct(t *testing.T) {
+	for _, body := range []string{productBody, `{"data": ` + productBody + `}`} {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, body))
+
+		p, err := m.GetProduct("s1", "p1")
+		if err != nil {
+			t.Fatalf("GetProduct: %v", err)
+		}
+		assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1/products/p1")
+		if p.ID != "p1" || p.DefaultSKUID != "k1" || p.Fields["name"] != "Shirt" || p.Fields["shippable"] != true {
+			t.Errorf("unexpected product %+v", p)
+		}
+		if len(p.SKUs) != 2 {
+			t.Fatalf("got %d SKUs, want 2", len(p.SKUs))
+		}
+		if k := p.SKUs[0]; k.ID != "k1" || k.ProductID != "p1" || k.Price != (Price{Value: 1999, Unit: "USD"}) || k.CompareAtPrice != nil {
+			t.Errorf("unexpected first SKU %+v", k)
+		}
+		if k := p.SKUs[1]; k.ID != "k2" || k.Fields["name"] != "Large" || k.CompareAtPrice == nil || k.CompareAtPrice.Value != 2999 {
+			t.Errorf("unexpected second SKU %+v", k)
+		}
+	}
+}
+
+func TestGetProductMissingIDs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, productBody))
+
+	if _, err := m.GetProduct("", "p1"); err != ErrorMissingSiteID {
+		t.Errorf("GetProduct without a site error = %v, want %v", err, ErrorMissingSiteID)
+	}
+	if _, err := m.GetProduct("s1", ""); err != ErrorMissingProductID {
+		t.Errorf("GetProduct without a product error = %v, want %v", err, ErrorMissingProductID)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestCreateProduct(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{
+		"product": {"_id": "p1", "name": "Shirt", "slug": "shirt", "default-sku": "k1"},
+		"sku": {"_id": "k1", "product": "p1", "price": {"value": 1999, "unit": "USD"}}
+	}`))
+
+	product := map[string]interface{}{"name": "Shirt", "slug": "shirt"}
+	sku := map[string]interface{}{"price": Price{Value: 1999, Unit: "USD"}}
+	p, err := m.CreateProduct("s1", product, sku)
+	if err != nil {
+		t.Fatalf("CreateProduct: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/sites/s1/products")
+	want := map[string]interface{}{
+		"product": map[string]interface{}{"fields": map[string]interface{}{"name": "Shirt", "slug": "shirt"}},
+		"sku":     map[string]interface{}{"fields": map[string]interface{}{"price": map[string]interface{}{"value": 1999.0, "unit": "USD"}}},
+	}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+	if p.ID != "p1" || len(p.SKUs) != 1 || p.SKUs[0].Price.Value != 1999 {
+		t.Errorf("unexpected product %+v", p)
+	}
+	if _, ok := DefaultSKU(p); !ok {
+		t.Error("the created product has no default SKU")
+	}
+}
+
+func TestCreateProductMissingFields(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	price := map[string]interface{}{"price": Price{Value: 100, Unit: "USD"}}
+	for _, tt := range []struct {
+		product, sku map[string]interface{}
+	}{
+		{map[string]interface{}{"slug": "shirt"}, price},
+		{map[string]interface{}{"name": "Shirt"}, price},
+		{map[string]interface{}{"name": "Shirt", "slug": "shirt"}, map[string]interface{}{}},
+	} {
+		if _, err := m.CreateProduct("s1", tt.product, tt.sku); err == nil {
+			t.Errorf("CreateProduct(%v, %v) succeeded", tt.product, tt.sku)
+		}
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestUpdateSKUPrice(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "k1", "product": "p1", "price": {"value": 2499, "unit": "USD"}, "name": "Shirt"}`))
+
+	sku, err := m.UpdateSKU("s1", "p1", "k1", map[string]interface{}{"price": Price{Value: 2499, Unit: "USD"}})
+	if err != nil {
+		t.Fatalf("UpdateSKU: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPatch, "/sites/s1/products/p1/skus/k1")
+	want := map[string]interface{}{
+		"sku": map[string]interface{}{"fields": map[string]interface{}{"price": map[string]interface{}{"value": 2499.0, "unit": "USD"}}},
+	}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+	if sku.Price != (Price{Value: 2499, Unit: "USD"}) || sku.Fields["name"] != "Shirt" {
+		t.Errorf("unexpected sku %+v", sku)
+	}
+}
+
+func TestGetInventory(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "k1", "quantity": 12, "inventoryType": "finite"}`))
+
+	inv, err := m.GetInventory("c1", "k1")
+	if err != nil {
+		t.Fatalf("GetInventory: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/collections/c1/items/k1/inventory")
+	if *inv != (Inventory{ID: "k1", Quantity: 12, InventoryType: InventoryFinite}) {
+		t.Errorf("unexpected inventory %+v", inv)
+	}
+}
+
+func TestUpdateInventory(t *testing.T) {
+	tests := []struct {
+		quantity int
+		infinite bool
+		want     map[string]interface{}
+	}{
+		{5, false, map[string]interface{}{"inventoryType": "finite", "quantity": 5.0}},
+		{5, true, map[string]interface{}{"inventoryType": "infinite"}},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "k1"}`))
+
+		if _, err := m.UpdateInventory("c1", "k1", tt.quantity, tt.infinite); err != nil {
+			t.Fatalf("UpdateInventory(infinite=%v): %v", tt.infinite, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPatch, "/collections/c1/items/k1/inventory")
+		if got := r.jsonBody(t); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("infinite=%v: body = %v, want %v", tt.infinite, got, tt.want)
+		}
+	}
+}
+
+func TestListAllSKUs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			rec.reply(http.StatusOK, `{"items": [
+				{"product": {"_id": "p1"}, "skus": [{"_id": "k1", "product": "p1"}, {"_id": "k2", "product": "p1"}]},
+				{"product": {"_id": "p2"}, "skus": [{"_id": "k3"}]}
+			], "count": 2, "offset": 0, "total": 3}`)(w, r)
+			return
+		}
+		rec.reply(http.StatusOK, `{"items": [
+			{"product": {"_id": "p3"}, "skus": [{"_id": "k4", "product": "p3"}, {"_id": "k5", "product": "p3"}]}
+		], "count": 1, "offset": 2, "total": 3}`)(w, r)
+	})
+
+	skus, err := m.ListAllSKUs("s1")
+	if err != nil {
+		t.Fatalf("ListAllSKUs: %v", err)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 2 {
+		t.Fatalf("%d requests were made, want 2", len(reqs))
+	}
+	assertRequest(t, reqs[0], http.MethodGet, "/sites/s1/products")
+	if got := reqs[1].Query.Get("offset"); got == "" {
+		t.Error("the second page was requested without an offset")
+	}
+	var got [][2]string
+	for _, sku := range skus {
+		got = append(got, [2]string{sku.ID, sku.ProductID})
+	}
+	want := [][2]string{{"k1", "p1"}, {"k2", "p1"}, {"k3", "p2"}, {"k4", "p3"}, {"k5", "p3"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SKUs and products = %v, want %v", got, want)
+	}
+}
+
+func TestCreateSKUs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"skus": [
+		{"_id": "k2", "product": "p1", "price": {"value": 1999, "unit": "USD"}},
+		{"_id": "k3", "product": "p1", "price": {"value": 2499, "unit": "USD"}}
+	]}`))
+
+	skus, err := m.CreateSKUs("s1", "p1", []map[string]interface{}{
+		{"name": "Medium", "price": Price{Value: 1999, Unit: "USD"}},
+		{"name": "Large", "price": Price{Value: 2499, Unit: "USD"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateSKUs: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/sites/s1/products/p1/skus")
+	want := map[string]interface{}{"skus": []interface{}{
+		map[string]interface{}{"fields": map[string]interface{}{"name": "Medium", "price": map[string]interface{}{"value": 1999.0, "unit": "USD"}}},
+		map[string]interface{}{"fields": map[string]interface{}{"name": "Large", "price": map[string]interface{}{"value": 2499.0, "unit": "USD"}}},
+	}}
+	if got := r.jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+	if len(skus) != 2 || skus[0].ID != "k2" || skus[1].Price.Value != 2499 {
+		t.Errorf("unexpected SKUs %+v", skus)
+	}
+}
+
+func TestCreateSKUsMissingPrice(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"skus": []}`))
+
+	_, err := m.CreateSKUs("s1", "p1", []map[string]interface{}{
+		{"name": "Medium", "price": Price{Value: 1999, Unit: "USD"}},
+		{"name": "Large"},
+	})
+	if err == nil {
+		t.Error("CreateSKUs succeeded with a SKU without a price")
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestDefaultSKU(t *testing.T) {
+	p := &Product{ID: "p1", DefaultSKUID: "k2", SKUs: []SKU{{ID: "k1"}, {ID: "k2", Price: Price{Value: 999, Unit: "USD"}}}}
+	sku, ok := DefaultSKU(p)
+	if !ok || sku.ID != "k2" || sku.Price.Value != 999 {
+		t.Errorf("DefaultSKU = %+v, %v, want k2", sku, ok)
+	}
+	if sku != &p.SKUs[1] {
+		t.Error("DefaultSKU returned a copy of the SKU instead of the product's")
+	}
+
+	for _, p := range []*Product{
+		nil,
+		{ID: "p1", SKUs: []SKU{{ID: "k1"}}},
+		{ID: "p1", DefaultSKUID: "k9", SKUs: []SKU{{ID: "k1"}, {ID: "k2"}}},
+	} {
+		if sku, ok := DefaultSKU(p); ok || sku != nil {
+			t.Errorf("DefaultSKU(%+v) = %+v, %v, want nil, false", p, sku, ok)
+		}
+	}
+}
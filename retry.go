@@ -0,0 +1,96 @@
+package webflow
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry when the API gives none.
+	retryBaseDelay = 500 * time.Millisecond
+	// retryMaxDelay caps the exponential backoff between retries.
+	retryMaxDelay = 30 * time.Second
+	// rateLimitWindow is the period after which the API's rate-limit budget resets.
+	rateLimitWindow = 60 * time.Second
+)
+
+// throttle waits until the rate-limit window resets when AutoThrottle is enabled and
+// the budget ran out on a previous request.
+func (m *Webflow) throttle(ctx context.Context) error {
+	if !m.AutoThrottle {
+		return nil
+	}
+	m.mu.Lock()
+	until := m.throttleUntil
+	m.mu.Unlock()
+	return sleep(ctx, time.Until(until))
+}
+
+// retryable reports whether a response with the given status is worth retrying.
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryAfter returns the delay requested by a Retry-After header, given either in
+// seconds or as an HTTP date.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// retryDelay returns how long to wait before the given retry attempt, honoring the
+// Retry-After header and otherwise backing off exponentially with jitter.
+func retryDelay(h http.Header, attempt int) time.Duration {
+	if d, ok := retryAfter(h); ok {
+		return d
+	}
+	d := retryMaxDelay
+	if attempt < 16 {
+		if b := retryBaseDelay << uint(attempt); b < d {
+			d = b
+		}
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
+
+// newIdempotencyKey returns a random key identifying a create request across its
+// retries.
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// sleep waits for d, returning early with the context's error when ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,203 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// flaky returns a handler failing the first failures requests with a retryable status
+// before replying with body.
+func flaky(rec *recorder, failures int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if n := len(rec.requests()); n < failures {
+			w.Header().Set("Retry-After", "0")
+			status := http.StatusServiceUnavailable
+			if n%2 == 1 {
+				status = http.StatusTooManyRequests
+			}
+			rec.reply(status, `{"msg": "Try again", "code": 503}`)(w, r)
+			return
+		}
+		rec.reply(http.StatusOK, body)(w, r)
+	}
+}
+
+func TestRequestRetries(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, flaky(&rec, 2, `[{"_id": "s1"}]`))
+	m.MaxRetries = 2
+
+	sites, err := m.ListSites()
+	if err != nil {
+		t.Fatalf("ListSites: %v", err)
+	}
+	if len(sites) != 1 || sites[0].ID != "s1" {
+		t.Errorf("unexpected sites %+v", sites)
+	}
+	if n := len(rec.requests()); n != 3 {
+		t.Errorf("%d requests were made, want 3", n)
+	}
+}
+
+func TestRequestRetriesExhausted(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, flaky(&rec, 2, `[]`))
+	m.MaxRetries = 1
+
+	if _, err := m.ListSites(); err == nil {
+		t.Error("ListSites succeeded after running out of retries")
+	}
+	if n := len(rec.requests()); n != 2 {
+		t.Errorf("%d requests were made, want 2", n)
+	}
+}
+
+func TestRequestNoRetriesByDefault(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, flaky(&rec, 1, `[]`))
+
+	if _, err := m.ListSites(); err == nil {
+		t.Error("ListSites succeeded without retrying")
+	}
+	if n := len(rec.requests()); n != 1 {
+		t.Errorf("%d requests were made, want 1", n)
+	}
+}
+
+func TestRequestRetryWaitCancel(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusBadGateway, `{}`))
+	m.MaxRetries = 5
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := m.ListSitesCtx(ctx); err != context.DeadlineExceeded {
+		t.Errorf("ListSitesCtx error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if n := len(rec.requests()); n != 1 {
+		t.Errorf("%d requests were made, want 1", n)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempt, max := range []time.Duration{retryBaseDelay, 2 * retryBaseDelay, 4 * retryBaseDelay} {
+		if d := retryDelay(http.Header{}, attempt); d < max/2 || d > max {
+			t.Errorf("retryDelay(attempt %d) = %s, want between %s and %s", attempt, d, max/2, max)
+		}
+	}
+	if d := retryDelay(http.Header{}, 40); d > retryMaxDelay {
+		t.Errorf("retryDelay(attempt 40) = %s, want at most %s", d, retryMaxDelay)
+	}
+	if d := retryDelay(http.Header{"Retry-After": {"7"}}, 0); d != 7*time.Second {
+		t.Errorf("retryDelay with Retry-After = %s, want 7s", d)
+	}
+}
+
+func TestAutoThrottle(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		rec.reply(http.StatusOK, `[]`)(w, r)
+	}, WithAutoThrottle())
+
+	if _, err := m.ListSites(); err != nil {
+		t.Fatalf("ListSites: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := m.ListSitesCtx(ctx); err != context.DeadlineExceeded {
+		t.Errorf("ListSitesCtx with a depleted budget error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if n := len(rec.requests()); n != 1 {
+		t.Errorf("%d requests were made, want 1", n)
+	}
+}
+
+func TestAutoThrottleRetryAfter(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("Retry-After", "0")
+		rec.reply(http.StatusOK, `[]`)(w, r)
+	}, WithAutoThrottle())
+
+	for i := 0; i < 2; i++ {
+		if _, err := m.ListSites(); err != nil {
+			t.Fatalf("ListSites: %v", err)
+		}
+	}
+	if n := len(rec.requests()); n != 2 {
+		t.Errorf("%d requests were made, want 2", n)
+	}
+}
+
+func TestRateLimitError(t *testing.T) {
+	tests := []struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		{"30", 30 * time.Second},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if tt.retryAfter != "" {
+				w.Header().Set("Retry-After", tt.retryAfter)
+			}
+			rec.reply(http.StatusTooManyRequests, `{"msg": "Rate limit hit", "code": 429, "name": "RateLimit"}`)(w, r)
+		})
+
+		_, err := m.ListSites()
+		e, ok := err.(RateLimitError)
+		if !ok {
+			t.Fatalf("Retry-After %q: error = %#v, want a RateLimitError", tt.retryAfter, err)
+		}
+		if e.RetryAfter != tt.want {
+			t.Errorf("Retry-After %q: RetryAfter = %v, want %v", tt.retryAfter, e.RetryAfter, tt.want)
+		}
+		var inner Error
+		if !errors.As(err, &inner) || inner.Status != http.StatusTooManyRequests || inner.Name != "RateLimit" {
+			t.Errorf("Retry-After %q: wrapped error = %#v, want the 429 Error", tt.retryAfter, inner)
+		}
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, flaky(&rec, 1, `{"_id": "i1"}`))
+	m.MaxRetries = 1
+
+	fields := map[string]interface{}{"name": "First", "slug": "first"}
+	if _, err := m.CreateItem("c1", fields, false); err != nil {
+		t.Fatalf("CreateItem: %v", err)
+	}
+	if _, err := m.CreateItem("c1", fields, false); err != nil {
+		t.Fatalf("CreateItem: %v", err)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 3 {
+		t.Fatalf("%d requests were made, want 3", len(reqs))
+	}
+	key := reqs[0].Header.Get("Idempotency-Key")
+	if key == "" {
+		t.Fatal("no Idempotency-Key was sent")
+	}
+	if got := reqs[1].Header.Get("Idempotency-Key"); got != key {
+		t.Errorf("retry Idempotency-Key = %q, want %q", got, key)
+	}
+	if got := reqs[2].Header.Get("Idempotency-Key"); got == "" || got == key {
+		t.Errorf("second create Idempotency-Key = %q, want a new key", got)
+	}
+
+	if _, err := m.GetSite("s1"); err != nil {
+		t.Fatalf("GetSite: %v", err)
+	}
+	if got := rec.last(t).Header.Get("Idempotency-Key"); got != "" {
+		t.Errorf("GET Idempotency-Key = %q, want none", got)
+	}
+}
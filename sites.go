@@ -0,0 +1,207 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Site defines a Webflow site.
+type Site struct {
+	ID            string    `json:"_id"`
+	Name          string    `json:"name"`
+	ShortName     string    `json:"shortName"`
+	CreatedOn     time.Time `json:"createdOn"`
+	LastPublished time.Time `json:"lastPublished"`
+	PreviewURL    string    `json:"previewUrl"`
+	Timezone      string    `json:"timezone"`
+}
+
+// ListSites returns all sites the access token has access to.
+func (m *Webflow) ListSites() ([]Site, error) {
+	return m.ListSitesCtx(context.Background())
+}
+
+// ListSitesCtx is like ListSites but uses ctx for the request.
+func (m *Webflow) ListSitesCtx(ctx context.Context) ([]Site, error) {
+	var sites []Site
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   "/sites",
+	}, &sites); err != nil {
+		if isEmptyListError(err) {
+			return []Site{}, nil
+		}
+		return nil, err
+	}
+	return sites, nil
+}
+
+// GetSite returns the site with the given ID.
+func (m *Webflow) GetSite(siteID string) (*Site, error) {
+	return m.GetSiteCtx(context.Background(), siteID)
+}
+
+// GetSiteCtx is like GetSite but uses ctx for the request.
+func (m *Webflow) GetSiteCtx(ctx context.Context, siteID string) (*Site, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	var site Site
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s", siteID),
+	}, &site); err != nil {
+		return nil, err
+	}
+	return &site, nil
+}
+
+// GetSiteByShortName returns the site with the given short name, the subdomain of its
+// webflow.io address. A site that doesn't exist is returned as an Error with a 404 code.
+func (m *Webflow) GetSiteByShortName(shortName string) (*Site, error) {
+	return m.GetSiteByShortNameCtx(context.Background(), shortName)
+}
+
+// GetSiteByShortNameCtx is like GetSiteByShortName but uses ctx for the request.
+func (m *Webflow) GetSiteByShortNameCtx(ctx context.Context, shortName string) (*Site, error) {
+	sites, err := m.ListSitesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sites {
+		if strings.EqualFold(sites[i].ShortName, shortName) {
+			return &sites[i], nil
+		}
+	}
+	return nil, Error{Message: fmt.Sprintf("Site %s not found", shortName), Code: http.StatusNotFound, Status: http.StatusNotFound}
+}
+
+// PublishSite publishes the site to the given domains. An empty list of domains is
+// treated by the API as a publish to all domains attached to the site. Publishing to a
+// domain that isn't attached to the site is returned as an Error.
+func (m *Webflow) PublishSite(siteID string, domains []string) error {
+	return m.PublishSiteCtx(context.Background(), siteID, domains)
+}
+
+// PublishSiteCtx is like PublishSite but uses ctx for the request.
+func (m *Webflow) PublishSiteCtx(ctx context.Context, siteID string, domains []string) error {
+	if siteID == "" {
+		return ErrorMissingSiteID
+	}
+	if domains == nil {
+		domains = []string{}
+	}
+	var res struct {
+		Queued bool `json:"queued"`
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method: http.MethodPost,
+		path:   fmt.Sprintf("/sites/%s/publish", siteID),
+		data: map[string]interface{}{
+			"domains": domains,
+		},
+	}, &res)
+}
+
+// PublishSiteByDomainNames publishes the site to the attached domains with the given
+// names, matched case-insensitively against the domains of the site. Names that don't
+// match an attached domain fail the publish before anything is published. Unlike
+// PublishSite, an empty list of names is an error rather than a publish to all domains.
+func (m *Webflow) PublishSiteByDomainNames(siteID string, names []string) error {
+	return m.PublishSiteByDomainNamesCtx(context.Background(), siteID, names)
+}
+
+// PublishSiteByDomainNamesCtx is like PublishSiteByDomainNames but uses ctx for the
+// requests.
+func (m *Webflow) PublishSiteByDomainNamesCtx(ctx context.Context, siteID string, names []string) error {
+	if len(names) == 0 {
+		return errors.New("missing webflow domain names")
+	}
+	domains, err := m.ListDomainsCtx(ctx, siteID)
+	if err != nil {
+		return err
+	}
+	attached := make(map[string]string, len(domains))
+	for _, d := range domains {
+		attached[strings.ToLower(d.Name)] = d.Name
+	}
+	var found, missing []string
+	for _, name := range names {
+		canonical, ok := attached[strings.ToLower(name)]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		found = append(found, canonical)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("webflow domains not attached to site %s: %s", siteID, strings.Join(missing, ", "))
+	}
+	return m.PublishSiteCtx(ctx, siteID, found)
+}
+
+// Domain defines a custom domain attached to a site.
+type Domain struct {
+	ID            string    `json:"_id"`
+	Name          string    `json:"name"`
+	LastPublished time.Time `json:"lastPublished"`
+}
+
+// ListDomains returns the domains attached to the site. The domain names are the
+// values accepted by PublishSite.
+func (m *Webflow) ListDomains(siteID string) ([]Domain, error) {
+	return m.ListDomainsCtx(context.Background(), siteID)
+}
+
+// ListDomainsCtx is like ListDomains but uses ctx for the request.
+func (m *Webflow) ListDomainsCtx(ctx context.Context, siteID string) ([]Domain, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	var domains []Domain
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s/domains", siteID),
+	}, &domains); err != nil {
+		if isEmptyListError(err) {
+			return []Domain{}, nil
+		}
+		return nil, err
+	}
+	return domains, nil
+}
+
+// defaultPollInterval is the interval WaitForPublish polls at when given none.
+const defaultPollInterval = 5 * time.Second
+
+// WaitForPublish polls the site every pollInterval until its last published time
+// changes from the one read on the first poll, so it's meant to be called right after
+// PublishSite. Comparing against the API's own timestamp keeps the wait unaffected by
+// clock skew. It returns the context's error once ctx is done, which is also how a
+// publish that completed before the first poll ends the wait.
+func (m *Webflow) WaitForPublish(ctx context.Context, siteID string, pollInterval time.Duration) error {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	site, err := m.GetSiteCtx(ctx, siteID)
+	if err != nil {
+		return err
+	}
+	previous := site.LastPublished
+	for {
+		if err := sleep(ctx, pollInterval); err != nil {
+			return err
+		}
+		site, err := m.GetSiteCtx(ctx, siteID)
+		if err != nil {
+			return err
+		}
+		if !site.LastPublished.Equal(previous) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListSites(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[
+		{"_id": "s1", "name": "First", "shortName": "first", "createdOn": "2021-03-04T05:06:07Z", "lastPublished": "2022-01-02T03:04:05.123Z", "previewUrl": "https://first.webflow.io/preview.png", "timezone": "Europe/Paris"},
+		{"_id": "s2", "name": "Second", "shortName": "second"}
+	]`))
+
+	sites, err := m.ListSites()
+	if err != nil {
+		t.Fatalf("ListSites: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites")
+	if len(sites) != 2 {
+		t.Fatalf("got %d sites, want 2", len(sites))
+	}
+	s := sites[0]
+	if s.ID != "s1" || s.Name != "First" || s.ShortName != "first" || s.Timezone != "Europe/Paris" || s.PreviewURL != "https://first.webflow.io/preview.png" {
+		t.Errorf("unexpected site %+v", s)
+	}
+	if want := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC); !s.CreatedOn.Equal(want) {
+		t.Errorf("CreatedOn = %v, want %v", s.CreatedOn, want)
+	}
+	if want := time.Date(2022, 1, 2, 3, 4, 5, 123e6, time.UTC); !s.LastPublished.Equal(want) {
+		t.Errorf("LastPublished = %v, want %v", s.LastPublished, want)
+	}
+	if sites[1].ID != "s2" {
+		t.Errorf("second site ID = %q, want s2", sites[1].ID)
+	}
+}
+
+func TestGetSite(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "s1", "name": "First", "shortName": "first"}`))
+
+	site, err := m.GetSite("s1")
+	if err != nil {
+		t.Fatalf("GetSite: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1")
+	if site.ID != "s1" || site.Name != "First" {
+		t.Errorf("unexpected site %+v", site)
+	}
+}
+
+func TestGetSiteMissingID(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	if _, err := m.GetSite(""); err != ErrorMissingSiteID {
+		t.Errorf("GetSite(\"\") error = %v, want %v", err, ErrorMissingSiteID)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestListDomains(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"data": [
+		{"_id": "d1", "name": "example.com", "lastPublished": "2022-05-06T07:08:09Z"},
+		{"_id": "d2", "name": "www.example.com"}
+	]}`))
+
+	domains, err := m.ListDomains("s1")
+	if err != nil {
+		t.Fatalf("ListDomains: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1/domains")
+	if len(domains) != 2 {
+		t.Fatalf("got %d domains, want 2", len(domains))
+	}
+	if d := domains[0]; d.ID != "d1" || d.Name != "example.com" || !d.LastPublished.Equal(time.Date(2022, 5, 6, 7, 8, 9, 0, time.UTC)) {
+		t.Errorf("unexpected domain %+v", d)
+	}
+	if d := domains[1]; d.ID != "d2" || d.Name != "www.example.com" {
+		t.Errorf("unexpected domain %+v", d)
+	}
+}
+
+func TestPublishSite(t *testing.T) {
+	tests := []struct {
+		domains []string
+		want    string
+	}{
+		{[]string{"example.com", "www.example.com"}, `{"domains":["example.com","www.example.com"]}`},
+		{[]string{}, `{"domains":[]}`},
+		{nil, `{"domains":[]}`},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, `{"queued": true}`))
+
+		if err := m.PublishSite("s1", tt.domains); err != nil {
+			t.Fatalf("PublishSite(%#v): %v", tt.domains, err)
+		}
+		r := rec.last(t)
+		assertRequest(t, r, http.MethodPost, "/sites/s1/publish")
+		if got := strings.TrimSpace(string(r.Body)); got != tt.want {
+			t.Errorf("PublishSite(%#v) body = %s, want %s", tt.domains, got, tt.want)
+		}
+	}
+}
+
+func TestPublishSiteBadRequest(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusBadRequest, `{"msg": "Domain not attached to site", "code": 400, "name": "ValidationError"}`))
+
+	err := m.PublishSite("s1", []string{"other.com"})
+	var e *Error
+	if !errors.As(err, &e) || e.Status != http.StatusBadRequest || e.Message != "Domain not attached to site" {
+		t.Errorf("PublishSite error = %#v, want the 400 Error", err)
+	}
+}
+
+// publishingSite returns a handler serving a site whose last published time, which is
+// far behind the local clock, changes from the given poll on.
+func publishingSite(rec *recorder, polls int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		published := "2020-01-01T00:00:00Z"
+		if len(rec.requests()) >= polls {
+			published = "2020-01-01T00:05:00Z"
+		}
+		rec.reply(http.StatusOK, fmt.Sprintf(`{"_id": "s1", "lastPublished": %q}`, published))(w, r)
+	}
+}
+
+func TestWaitForPublish(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, publishingSite(&rec, 3))
+
+	if err := m.WaitForPublish(context.Background(), "s1", time.Millisecond); err != nil {
+		t.Fatalf("WaitForPublish: %v", err)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 4 {
+		t.Errorf("%d polls were made, want 4", len(reqs))
+	}
+	for _, r := range reqs {
+		assertRequest(t, r, http.MethodGet, "/sites/s1")
+	}
+}
+
+func TestWaitForPublishCancel(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, publishingSite(&rec, 1000))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := m.WaitForPublish(ctx, "s1", time.Millisecond); err != context.DeadlineExceeded {
+		t.Errorf("WaitForPublish error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+// domainsSite returns a handler serving the domains of a site and accepting publishes.
+func domainsSite(rec *recorder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			rec.reply(http.StatusOK, `{"queued": true}`)(w, r)
+			return
+		}
+		rec.reply(http.StatusOK, `[{"_id": "d1", "name": "example.com"}, {"_id": "d2", "name": "www.example.com"}]`)(w, r)
+	}
+}
+
+func TestPublishSiteByDomainNames(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, domainsSite(&rec))
+
+	if err := m.PublishSiteByDomainNames("s1", []string{"WWW.Example.com"}); err != nil {
+		t.Fatalf("PublishSiteByDomainNames: %v", err)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/sites/s1/publish")
+	if got, want := r.jsonBody(t)["domains"], []interface{}{"www.example.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("published to %v, want %v", got, want)
+	}
+}
+
+func TestPublishSiteByDomainNamesMismatch(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, domainsSite(&rec))
+
+	err := m.PublishSiteByDomainNames("s1", []string{"example.com", "shop.example.com"})
+	if err == nil || !strings.Contains(err.Error(), "shop.example.com") || strings.Contains(err.Error(), "example.com,") {
+		t.Errorf("PublishSiteByDomainNames error = %v, want one listing shop.example.com", err)
+	}
+	for _, r := range rec.requests() {
+		if r.Method == http.MethodPost {
+			t.Error("the site was published despite a mismatched name")
+		}
+	}
+}
+
+func TestPublishSiteByDomainNamesEmpty(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, domainsSite(&rec))
+
+	for _, names := range [][]string{nil, {}} {
+		if err := m.PublishSiteByDomainNames("s1", names); err == nil {
+			t.Errorf("PublishSiteByDomainNames(%#v) succeeded", names)
+		}
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+func TestGetSiteByShortName(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `[{"_id": "s1", "shortName": "first"}, {"_id": "s2", "shortName": "second"}]`))
+
+	site, err := m.GetSiteByShortName("Second")
+	if err != nil {
+		t.Fatalf("GetSiteByShortName: %v", err)
+	}
+	if site.ID != "s2" {
+		t.Errorf("site ID = %q, want s2", site.ID)
+	}
+
+	_, err = m.GetSiteByShortName("third")
+	var e Error
+	if !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("GetSiteByShortName of a missing site error = %#v, want a 404 Error", err)
+	}
+}
@@ -0,0 +1,46 @@
+package webflow
+
+import (
+	"context"
+	"net/http"
+)
+
+// User defines the Webflow user that authorized the access token.
+type User struct {
+	ID        string `json:"_id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+// GetAuthenticatedUser returns the user behind the client's access token, which v1 of
+// the API reports with the token's authorization info and v2 on its own. A rejected
+// token is returned as an Error carrying the API's error code.
+func (m *Webflow) GetAuthenticatedUser() (*User, error) {
+	return m.GetAuthenticatedUserCtx(context.Background())
+}
+
+// GetAuthenticatedUserCtx is like GetAuthenticatedUser but uses ctx for the request.
+func (m *Webflow) GetAuthenticatedUserCtx(ctx context.Context) (*User, error) {
+	cr := withContextSettings(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   "/user",
+	})
+	if m.apiVersion(cr) == APIVersion1 {
+		cr.path = "/info"
+	}
+	var res struct {
+		User  *User  `json:"user"`
+		Users []User `json:"users"`
+	}
+	if err := m.requestCtx(ctx, cr, &res); err != nil {
+		return nil, err
+	}
+	if res.User != nil {
+		return res.User, nil
+	}
+	if len(res.Users) > 0 {
+		return &res.Users[0], nil
+	}
+	return nil, Error{Message: "Authenticated user not found", Code: http.StatusNotFound, Status: http.StatusNotFound}
+}
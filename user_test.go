@@ -0,0 +1,56 @@
+package webflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetAuthenticatedUser(t *testing.T) {
+	tests := []struct {
+		version APIVersion
+		path    string
+		body    string
+	}{
+		{APIVersion1, "/info", `{"_id": "a1", "grantType": "authorization_code", "users": [{"_id": "u1", "email": "one@example.com", "firstName": "First", "lastName": "Last"}]}`},
+		{APIVersion2, "/v2/user", `{"user": {"_id": "u1", "email": "one@example.com", "firstName": "First", "lastName": "Last"}}`},
+	}
+	for _, tt := range tests {
+		var rec recorder
+		m := newTestClient(t, rec.reply(http.StatusOK, tt.body), WithAPIVersion(tt.version))
+
+		u, err := m.GetAuthenticatedUser()
+		if err != nil {
+			t.Fatalf("v%d GetAuthenticatedUser: %v", tt.version, err)
+		}
+		assertRequest(t, rec.last(t), http.MethodGet, tt.path)
+		if want := (User{ID: "u1", Email: "one@example.com", FirstName: "First", LastName: "Last"}); *u != want {
+			t.Errorf("v%d user = %+v, want %+v", tt.version, *u, want)
+		}
+	}
+}
+
+func TestGetAuthenticatedUserRequestAPIVersion(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"user": {"_id": "u1"}}`))
+
+	if _, err := m.GetAuthenticatedUserCtx(WithRequestAPIVersion(context.Background(), APIVersion2)); err != nil {
+		t.Fatalf("GetAuthenticatedUserCtx: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/v2/user")
+}
+
+func TestGetAuthenticatedUserUnauthorized(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusUnauthorized, `{"msg": "Not Authorized", "code": 401, "name": "NotAuthorized"}`))
+
+	_, err := m.GetAuthenticatedUser()
+	var e Error
+	if !errors.As(err, &e) {
+		t.Fatalf("GetAuthenticatedUser error = %#v, want an Error", err)
+	}
+	if e.Code != http.StatusUnauthorized || e.Status != http.StatusUnauthorized || e.Name != "NotAuthorized" {
+		t.Errorf("unexpected error %#v", e)
+	}
+}
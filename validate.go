@@ -0,0 +1,139 @@
+package webflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// fieldKinds maps the field types of a collection's schema to the kinds of Go values
+// they accept. Types missing from the map accept any value.
+var fieldKinds = map[string]func(v interface{}) bool{
+	"PlainText":      isString,
+	"RichText":       isString,
+	"Email":          isString,
+	"Phone":          isString,
+	"Link":           isString,
+	"Color":          isString,
+	"Video":          isString,
+	"Option":         isString,
+	"ItemRef":        isString,
+	"Reference":      isString,
+	"Bool":           isBool,
+	"Switch":         isBool,
+	"Number":         isNumber,
+	"Date":           isDate,
+	"DateTime":       isDate,
+	"ImageRef":       isFile,
+	"Image":          isFile,
+	"File":           isFile,
+	"ExtFileRef":     isFile,
+	"ItemRefSet":     isList,
+	"MultiReference": isList,
+	"Set":            isList,
+	"MultiImage":     isList,
+}
+
+// ValidateItemFields checks item fields against the collection's schema before they're
+// sent to the API. Every key must be the slug of a field of the collection, every
+// required editable field must be present and every value must be of a Go type that
+// matches its field type. Keys starting with an underscore, such as _archived and
+// _draft, are always accepted.
+func ValidateItemFields(collection *Collection, fields map[string]interface{}) error {
+	if collection == nil {
+		return errors.New("missing webflow collection")
+	}
+	schema := make(map[string]Field, len(collection.Fields))
+	for _, f := range collection.Fields {
+		schema[f.Slug] = f
+	}
+
+	var problems []string
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		f, ok := schema[k]
+		if !ok {
+			if !strings.HasPrefix(k, "_") {
+				problems = append(problems, fmt.Sprintf("unknown field %q", k))
+			}
+			continue
+		}
+		v := fields[k]
+		if v == nil {
+			continue
+		}
+		if kind, ok := fieldKinds[f.Type]; ok && !kind(v) {
+			problems = append(problems, fmt.Sprintf("field %q of type %s can't be %T", k, f.Type, v))
+		}
+	}
+	for _, f := range collection.Fields {
+		if !f.Required || !f.Editable {
+			continue
+		}
+		if v, ok := fields[f.Slug]; !ok || v == nil {
+			problems = append(problems, fmt.Sprintf("missing required field %q", f.Slug))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid webflow item fields: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// isString reports whether v is a string.
+func isString(v interface{}) bool {
+	_, ok := v.(string)
+	return ok
+}
+
+// isBool reports whether v is a bool.
+func isBool(v interface{}) bool {
+	_, ok := v.(bool)
+	return ok
+}
+
+// isNumber reports whether v is an integer, a float or a json.Number.
+func isNumber(v interface{}) bool {
+	if _, ok := v.(json.Number); ok {
+		return true
+	}
+	switch reflect.TypeOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// isDate reports whether v is a time.Time or a string holding a date.
+func isDate(v interface{}) bool {
+	switch v.(type) {
+	case time.Time, *time.Time, string:
+		return true
+	}
+	return false
+}
+
+// isFile reports whether v is a file ID, a URL or an object describing a file.
+func isFile(v interface{}) bool {
+	switch v.(type) {
+	case string, map[string]interface{}, map[string]string:
+		return true
+	}
+	return false
+}
+
+// isList reports whether v is a slice or an array.
+func isList(v interface{}) bool {
+	k := reflect.TypeOf(v).Kind()
+	return k == reflect.Slice || k == reflect.Array
+}
@@ -0,0 +1,54 @@
+package webflow
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// validationSchema is a collection covering the field types checked by
+// ValidateItemFields.
+var validationSchema = &Collection{
+	Fields: []Field{
+		{Slug: "name", Type: "PlainText", Required: true, Editable: true},
+		{Slug: "slug", Type: "PlainText", Required: true, Editable: true},
+		{Slug: "featured", Type: "Switch", Editable: true},
+		{Slug: "rank", Type: "Number", Editable: true},
+		{Slug: "published-on", Type: "Date", Editable: true},
+		{Slug: "tags", Type: "ItemRefSet", Editable: true},
+		{Slug: "created-by", Type: "User", Required: true},
+	},
+}
+
+func TestValidateItemFields(t *testing.T) {
+	valid := []map[string]interface{}{
+		{"name": "First", "slug": "first"},
+		{"name": "First", "slug": "first", "featured": true, "rank": 3, "published-on": time.Now(), "tags": []string{"t1"}, "_draft": true},
+		{"name": "First", "slug": "first", "rank": 2.5, "featured": nil},
+	}
+	for _, fields := range valid {
+		if err := ValidateItemFields(validationSchema, fields); err != nil {
+			t.Errorf("ValidateItemFields(%v): %v", fields, err)
+		}
+	}
+
+	invalid := []struct {
+		fields map[string]interface{}
+		want   string
+	}{
+		{map[string]interface{}{"name": "First"}, `missing required field "slug"`},
+		{map[string]interface{}{"name": "First", "slug": "first", "colour": "red"}, `unknown field "colour"`},
+		{map[string]interface{}{"name": "First", "slug": "first", "featured": "yes"}, `field "featured" of type Switch can't be string`},
+		{map[string]interface{}{"name": 1, "slug": "first"}, `field "name" of type PlainText can't be int`},
+		{map[string]interface{}{"name": "First", "slug": "first", "tags": "t1"}, `field "tags" of type ItemRefSet can't be string`},
+	}
+	for _, tt := range invalid {
+		err := ValidateItemFields(validationSchema, tt.fields)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ValidateItemFields(%v) error = %v, want one containing %q", tt.fields, err, tt.want)
+		}
+	}
+	if err := ValidateItemFields(nil, map[string]interface{}{}); err == nil {
+		t.Error("ValidateItemFields without a collection succeeded")
+	}
+}
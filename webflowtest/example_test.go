@@ -0,0 +1,50 @@
+package webflowtest_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/jumbletv/webflow/webflowtest"
+)
+
+func ExampleNewTestServer() {
+	m, done := webflowtest.NewTestServer(map[string]http.HandlerFunc{
+		"GET /sites": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`[{"_id": "site-id", "name": "Site"}]`))
+		},
+	})
+	defer done()
+
+	sites, err := m.ListSites()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(sites[0].ID, sites[0].Name)
+
+	if _, err := m.GetSite("missing"); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// site-id Site
+	// Webflow: Route not found (404)
+}
+
+func ExampleNewServer() {
+	m, srv := webflowtest.NewServer(map[string]http.HandlerFunc{
+		"POST /collections/collection-id/items": func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"_id": "item-id", "name": "Item", "slug": "item"}`))
+		},
+	})
+	defer srv.Close()
+
+	if _, err := m.CreateItem("collection-id", map[string]interface{}{"name": "Item", "slug": "item"}, false); err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, r := range srv.Requests() {
+		fmt.Println(r.Method, r.Path, string(r.Body))
+	}
+	// Output:
+	// POST /collections/collection-id/items {"fields":{"name":"Item","slug":"item"}}
+}
@@ -0,0 +1,97 @@
+// Package webflowtest provides a fake Webflow API for testing code built on the
+// webflow client.
+package webflowtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/jumbletv/webflow"
+)
+
+// token is the access token of clients returned by NewTestServer.
+const token = "webflowtest-token"
+
+// Request holds the parts of a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Webflow API that records the requests it receives.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []Request
+}
+
+// Requests returns the requests received by the server so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// NewTestServer starts a fake Webflow API serving the given handlers and returns a
+// client pointed at it, along with a func that shuts the server down. Handlers are
+// keyed by request path, optionally preceded by a method, and the method-specific
+// handler takes precedence:
+//
+//	m, done := webflowtest.NewTestServer(map[string]http.HandlerFunc{
+//		"GET /sites": func(w http.ResponseWriter, r *http.Request) {
+//			w.Write([]byte(`[{"_id": "site-id", "name": "Site"}]`))
+//		},
+//	})
+//	defer done()
+//
+// Requests without a handler are answered with a 404 shaped like the API's errors.
+func NewTestServer(handlers map[string]http.HandlerFunc) (*webflow.Webflow, func()) {
+	m, srv := NewServer(handlers)
+	return m, srv.Close
+}
+
+// NewServer is like NewTestServer but returns the server itself, whose URL and received
+// requests are there for tests to assert on. Close it once done.
+func NewServer(handlers map[string]http.HandlerFunc) (*webflow.Webflow, *Server) {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+		s.mu.Unlock()
+
+		h, ok := handlers[r.Method+" "+r.URL.Path]
+		if !ok {
+			h, ok = handlers[r.URL.Path]
+		}
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"msg": "Route not found", "code": 404, "name": "RouteNotFoundError"}`))
+			return
+		}
+		h(w, r)
+	}))
+	m, err := webflow.NewClient(token, webflow.WithHost(s.URL))
+	if err != nil {
+		s.Close()
+		panic(err)
+	}
+	m.Transport = s.Client().Transport
+	return m, s
+}
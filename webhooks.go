@@ -0,0 +1,167 @@
+package webflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Trigger types of the events a webhook can be registered for.
+const (
+	TriggerFormSubmission            = "form_submission"
+	TriggerSitePublish               = "site_publish"
+	TriggerEcommNewOrder             = "ecomm_new_order"
+	TriggerEcommOrderChanged         = "ecomm_order_changed"
+	TriggerEcommInventoryChanged     = "ecomm_inventory_changed"
+	TriggerCollectionItemCreated     = "collection_item_created"
+	TriggerCollectionItemChanged     = "collection_item_changed"
+	TriggerCollectionItemDeleted     = "collection_item_deleted"
+	TriggerCollectionItemUnpublished = "collection_item_unpublished"
+)
+
+// triggerTypes is the set of trigger types known to the API.
+var triggerTypes = map[string]bool{
+	TriggerFormSubmission:            true,
+	TriggerSitePublish:               true,
+	TriggerEcommNewOrder:             true,
+	TriggerEcommOrderChanged:         true,
+	TriggerEcommInventoryChanged:     true,
+	TriggerCollectionItemCreated:     true,
+	TriggerCollectionItemChanged:     true,
+	TriggerCollectionItemDeleted:     true,
+	TriggerCollectionItemUnpublished: true,
+}
+
+// Webhook defines a webhook registered on a site.
+type Webhook struct {
+	ID          string                 `json:"_id"`
+	TriggerType string                 `json:"triggerType"`
+	URL         string                 `json:"url"`
+	CreatedOn   time.Time              `json:"createdOn"`
+	Filter      map[string]interface{} `json:"filter,omitempty"`
+}
+
+// ListWebhooks returns the webhooks registered on the site.
+func (m *Webflow) ListWebhooks(siteID string) ([]Webhook, error) {
+	return m.ListWebhooksCtx(context.Background(), siteID)
+}
+
+// ListWebhooksCtx is like ListWebhooks but uses ctx for the request.
+func (m *Webflow) ListWebhooksCtx(ctx context.Context, siteID string) ([]Webhook, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	var webhooks []Webhook
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s/webhooks", siteID),
+	}, &webhooks); err != nil {
+		if isEmptyListError(err) {
+			return []Webhook{}, nil
+		}
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// CreateWebhook registers a webhook on the site that posts events of the given trigger
+// type to url. The filter is optional and only sent when non-nil.
+func (m *Webflow) CreateWebhook(siteID, triggerType, url string, filter map[string]interface{}) (*Webhook, error) {
+	return m.CreateWebhookCtx(context.Background(), siteID, triggerType, url, filter)
+}
+
+// CreateWebhookCtx is like CreateWebhook but uses ctx for the request.
+func (m *Webflow) CreateWebhookCtx(ctx context.Context, siteID, triggerType, url string, filter map[string]interface{}) (*Webhook, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if !triggerTypes[triggerType] {
+		return nil, fmt.Errorf("unknown webflow webhook trigger type %q", triggerType)
+	}
+	data := map[string]interface{}{
+		"triggerType": triggerType,
+		"url":         url,
+	}
+	if filter != nil {
+		data["filter"] = filter
+	}
+	var webhook Webhook
+	if err := m.requestCtx(ctx, clientRequest{
+		method:         http.MethodPost,
+		path:           fmt.Sprintf("/sites/%s/webhooks", siteID),
+		data:           data,
+		idempotencyKey: newIdempotencyKey(),
+	}, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// EnsureWebhook returns the webhook of the site with the given trigger type and URL,
+// creating it with the given filter only when no such webhook exists yet. The filter of
+// an existing webhook is left as it is.
+func (m *Webflow) EnsureWebhook(siteID, triggerType, url string, filter map[string]interface{}) (*Webhook, error) {
+	return m.EnsureWebhookCtx(context.Background(), siteID, triggerType, url, filter)
+}
+
+// EnsureWebhookCtx is like EnsureWebhook but uses ctx for the requests.
+func (m *Webflow) EnsureWebhookCtx(ctx context.Context, siteID, triggerType, url string, filter map[string]interface{}) (*Webhook, error) {
+	webhooks, err := m.ListWebhooksCtx(ctx, siteID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range webhooks {
+		if webhooks[i].TriggerType == triggerType && webhooks[i].URL == url {
+			return &webhooks[i], nil
+		}
+	}
+	return m.CreateWebhookCtx(ctx, siteID, triggerType, url, filter)
+}
+
+// GetWebhook returns the webhook with the given ID. A webhook that doesn't exist is
+// returned as an Error with a 404 code.
+func (m *Webflow) GetWebhook(siteID, webhookID string) (*Webhook, error) {
+	return m.GetWebhookCtx(context.Background(), siteID, webhookID)
+}
+
+// GetWebhookCtx is like GetWebhook but uses ctx for the request.
+func (m *Webflow) GetWebhookCtx(ctx context.Context, siteID, webhookID string) (*Webhook, error) {
+	if siteID == "" {
+		return nil, ErrorMissingSiteID
+	}
+	if webhookID == "" {
+		return nil, ErrorMissingWebhookID
+	}
+	var webhook Webhook
+	if err := m.requestCtx(ctx, clientRequest{
+		method: http.MethodGet,
+		path:   fmt.Sprintf("/sites/%s/webhooks/%s", siteID, webhookID),
+	}, &webhook); err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// RemoveWebhook removes the webhook with the given ID from the site. A webhook that
+// doesn't exist is returned as an Error with a 404 code.
+func (m *Webflow) RemoveWebhook(siteID, webhookID string) error {
+	return m.RemoveWebhookCtx(context.Background(), siteID, webhookID)
+}
+
+// RemoveWebhookCtx is like RemoveWebhook but uses ctx for the request.
+func (m *Webflow) RemoveWebhookCtx(ctx context.Context, siteID, webhookID string) error {
+	if siteID == "" {
+		return ErrorMissingSiteID
+	}
+	if webhookID == "" {
+		return ErrorMissingWebhookID
+	}
+	var res struct {
+		Deleted int `json:"deleted"`
+	}
+	return m.requestCtx(ctx, clientRequest{
+		method: http.MethodDelete,
+		path:   fmt.Sprintf("/sites/%s/webhooks/%s", siteID, webhookID),
+	}, &res)
+}
@@ -0,0 +1,192 @@
+package webflow
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListWebhooks(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"data": [
+		{"_id": "w1", "triggerType": "form_submission", "url": "https://example.com/forms", "createdOn": "2022-01-02T03:04:05Z", "filter": {"name": "Contact"}},
+		{"_id": "w2", "triggerType": "site_publish", "url": "https://example.com/publish"},
+		{"_id": "w3", "triggerType": "collection_item_changed", "url": "https://example.com/items"}
+	]}`))
+
+	webhooks, err := m.ListWebhooks("s1")
+	if err != nil {
+		t.Fatalf("ListWebhooks: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1/webhooks")
+	if len(webhooks) != 3 {
+		t.Fatalf("got %d webhooks, want 3", len(webhooks))
+	}
+	for i, want := range []string{TriggerFormSubmission, TriggerSitePublish, TriggerCollectionItemChanged} {
+		if webhooks[i].TriggerType != want {
+			t.Errorf("webhook %d trigger type = %q, want %q", i, webhooks[i].TriggerType, want)
+		}
+	}
+	if w := webhooks[0]; w.ID != "w1" || w.URL != "https://example.com/forms" || w.CreatedOn.IsZero() || w.Filter["name"] != "Contact" {
+		t.Errorf("unexpected webhook %+v", w)
+	}
+	if webhooks[1].Filter != nil {
+		t.Errorf("webhook without filter has filter %v", webhooks[1].Filter)
+	}
+}
+
+func TestCreateWebhook(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "w1", "triggerType": "site_publish", "url": "https://example.com/publish"}`))
+
+	webhook, err := m.CreateWebhook("s1", TriggerSitePublish, "https://example.com/publish", nil)
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if webhook.ID != "w1" {
+		t.Errorf("webhook ID = %q, want w1", webhook.ID)
+	}
+	r := rec.last(t)
+	assertRequest(t, r, http.MethodPost, "/sites/s1/webhooks")
+	body := r.jsonBody(t)
+	if body["triggerType"] != TriggerSitePublish || body["url"] != "https://example.com/publish" {
+		t.Errorf("unexpected body %v", body)
+	}
+	if _, ok := body["filter"]; ok {
+		t.Errorf("body has a filter without one being given: %v", body)
+	}
+
+	if _, err := m.CreateWebhook("s1", TriggerFormSubmission, "https://example.com/forms", map[string]interface{}{"name": "Contact"}); err != nil {
+		t.Fatalf("CreateWebhook with filter: %v", err)
+	}
+	if filter, _ := rec.last(t).jsonBody(t)["filter"].(map[string]interface{}); filter["name"] != "Contact" {
+		t.Errorf("filter = %v, want the given filter", filter)
+	}
+}
+
+func TestCreateWebhookUnknownTriggerType(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	if _, err := m.CreateWebhook("s1", "site_published", "https://example.com/publish", nil); err == nil {
+		t.Error("CreateWebhook with an unknown trigger type succeeded")
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+const webhookNotFound = `{"msg": "Requested resource not found", "code": 404, "name": "NotFound"}`
+
+func TestRemoveWebhook(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"deleted": 1}`))
+
+	if err := m.RemoveWebhook("s1", "w1"); err != nil {
+		t.Fatalf("RemoveWebhook: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodDelete, "/sites/s1/webhooks/w1")
+}
+
+func TestRemoveWebhookNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, webhookNotFound))
+
+	var e Error
+	if err := m.RemoveWebhook("s1", "w1"); !errors.As(err, &e) || e.Status != http.StatusNotFound {
+		t.Errorf("RemoveWebhook error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestGetWebhook(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{"_id": "w1", "triggerType": "form_submission", "url": "https://example.com/forms", "filter": {"name": "Contact"}}`))
+
+	webhook, err := m.GetWebhook("s1", "w1")
+	if err != nil {
+		t.Fatalf("GetWebhook: %v", err)
+	}
+	assertRequest(t, rec.last(t), http.MethodGet, "/sites/s1/webhooks/w1")
+	if webhook.URL != "https://example.com/forms" || webhook.Filter["name"] != "Contact" {
+		t.Errorf("unexpected webhook %+v", webhook)
+	}
+}
+
+func TestGetWebhookNotFound(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusNotFound, webhookNotFound))
+
+	var e Error
+	if _, err := m.GetWebhook("s1", "w1"); !errors.As(err, &e) || e.Status != http.StatusNotFound || e.Name != "NotFound" {
+		t.Errorf("GetWebhook error = %#v, want a 404 Error", err)
+	}
+}
+
+func TestGetWebhookMissingIDs(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, rec.reply(http.StatusOK, `{}`))
+
+	if _, err := m.GetWebhook("", "w1"); err != ErrorMissingSiteID {
+		t.Errorf("GetWebhook without site ID error = %v, want %v", err, ErrorMissingSiteID)
+	}
+	if _, err := m.GetWebhook("s1", ""); err != ErrorMissingWebhookID {
+		t.Errorf("GetWebhook without webhook ID error = %v, want %v", err, ErrorMissingWebhookID)
+	}
+	if n := len(rec.requests()); n != 0 {
+		t.Errorf("%d requests were made, want none", n)
+	}
+}
+
+// existingWebhooks returns a handler listing a webhook for site publishes and accepting
+// new webhooks.
+func existingWebhooks(rec *recorder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			rec.reply(http.StatusOK, `{"_id": "w2", "triggerType": "form_submission", "url": "https://example.com/publish"}`)(w, r)
+			return
+		}
+		rec.reply(http.StatusOK, `[{"_id": "w1", "triggerType": "site_publish", "url": "https://example.com/publish"}]`)(w, r)
+	}
+}
+
+func TestEnsureWebhookExists(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, existingWebhooks(&rec))
+
+	webhook, err := m.EnsureWebhook("s1", TriggerSitePublish, "https://example.com/publish", nil)
+	if err != nil {
+		t.Fatalf("EnsureWebhook: %v", err)
+	}
+	if webhook.ID != "w1" {
+		t.Errorf("webhook ID = %q, want the existing w1", webhook.ID)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 1 {
+		t.Fatalf("%d requests were made, want 1", len(reqs))
+	}
+	assertRequest(t, reqs[0], http.MethodGet, "/sites/s1/webhooks")
+}
+
+func TestEnsureWebhookCreates(t *testing.T) {
+	var rec recorder
+	m := newTestClient(t, existingWebhooks(&rec))
+
+	// The URL matches the existing webhook but the trigger type doesn't.
+	webhook, err := m.EnsureWebhook("s1", TriggerFormSubmission, "https://example.com/publish", nil)
+	if err != nil {
+		t.Fatalf("EnsureWebhook: %v", err)
+	}
+	if webhook.ID != "w2" {
+		t.Errorf("webhook ID = %q, want the created w2", webhook.ID)
+	}
+	reqs := rec.requests()
+	if len(reqs) != 2 {
+		t.Fatalf("%d requests were made, want 2", len(reqs))
+	}
+	assertRequest(t, reqs[1], http.MethodPost, "/sites/s1/webhooks")
+	want := map[string]interface{}{"triggerType": "form_submission", "url": "https://example.com/publish"}
+	if got := reqs[1].jsonBody(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+}